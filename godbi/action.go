@@ -14,11 +14,21 @@ type Capability interface {
 
 // Action is the base struct for REST actions. Prepares and Nextpages are edges to other tables before and after the action.
 type Action struct {
-	ActionName string        `json:"actionName,omitempty" hcl:"actionName,label"`
+	ActionName string `json:"actionName,omitempty" hcl:"actionName,label"`
+	// ActionType is the built-in or registered type of the action,
+	// e.g. topics. It is needed only if different from ActionName.
+	ActionType string        `json:"actionType,omitempty" hcl:"actionType,optional"`
 	Picked     []string      `json:"picked,omitempty" hcl:"picked,optional"`
 	Prepares   []*Connection `json:"prepares,omitempty" hcl:"prepares,block"`
 	Nextpages  []*Connection `json:"nextpages,omitempty" hcl:"nextpages,block"`
-	IsDo       bool          `json:"-" hcl:"-"`
+	// Timeout bounds the action, with its prepares and nextpages,
+	// e.g. 500ms or 2s
+	Timeout string `json:"timeout,omitempty" hcl:"timeout,optional"`
+	IsDo    bool   `json:"-" hcl:"-"`
+	// the SELECT clause and labels precomputed by Molecule.Compile for table
+	selectSQL    string
+	selectLabels []any
+	selectTable  *Table
 }
 
 // GetBaseAction gets the base action
@@ -37,9 +47,17 @@ func (a *Action) getAllowed() map[string]bool {
 	return allowed
 }
 
-func getSQL(ctx context.Context, db *sql.DB, logger Slogger, statement string, labels []any, ids ...any) ([]any, error) {
+// selectPars returns the SELECT clause and labels of table, using the
+// precomputed ones unless fields are picked in args
+func (a *Action) selectPars(t *Table, args map[string]any, fieldsName string) (string, []any) {
+	if a.selectTable == t && !(hasValue(args) && hasValue(args[fieldsName])) {
+		return a.selectSQL, a.selectLabels
+	}
+	return t.filterPars(args, fieldsName, a.getAllowed())
+}
+
+func getSQL(ctx context.Context, dbi *DBI, statement string, labels []any, ids ...any) ([]any, error) {
 	lists := make([]any, 0)
-	dbi := &DBI{DB: db, logger: logger}
 	err := dbi.SelectSQLContext(ctx, &lists, statement, labels, ids...)
 	return lists, err
 }
@@ -2,6 +2,7 @@ package godbi
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/genelet/horizon/dethcl"
@@ -42,7 +43,7 @@ func TestAction(t *testing.T) {
 
 	db.Exec(`drop table if exists m_a`)
 	db.Exec(`CREATE TABLE m_a (id int auto_increment not null primary key,
-        x varchar(8), y varchar(8), z varchar(8))`)
+        x varchar(8), y varchar(8), z varchar(8), UNIQUE (x, y))`)
 
 	tstr := `{
     "tableName":"m_a",
@@ -152,3 +153,211 @@ func TestAction(t *testing.T) {
 	db.Exec(`drop table if exists m_a`)
 	db.Exec(`drop table if exists m_b`)
 }
+
+func TestActionReturning(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`CREATE TABLE m_a (id integer primary key autoincrement,
+        x varchar(8) not null unique, y varchar(8) not null default 'yy', n int not null default 7)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := &Table{
+		TableName: "m_a",
+		Pks:       []string{"id"},
+		IDAuto:    "id",
+		Uniques:   []string{"x"},
+		Columns: []*Col{
+			{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+			{ColumnName: "x", Label: "x", TypeName: "string", Notnull: true},
+			{ColumnName: "y", Label: "y", TypeName: "string"},
+			{ColumnName: "n", Label: "n", TypeName: "int", Auto: true},
+		},
+	}
+	table.SetDBDriver(SQLite)
+
+	insert := &Insert{Returning: true}
+	lists, err := insert.RunAction(db, table, map[string]any{"x": "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := lists[0].(map[string]any)
+	if row["id"] != 1 || row["x"] != "a" || row["y"] != "yy" || row["n"] != 7 {
+		t.Errorf("%#v", row)
+	}
+
+	update := &Update{Returning: true}
+	lists, err = update.RunAction(db, table, map[string]any{"id": 1.0, "x": "b", "y": "zz"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row = lists[0].(map[string]any)
+	if row["id"] != 1 || row["x"] != "b" || row["y"] != "zz" || row["n"] != 7 {
+		t.Errorf("%#v", row)
+	}
+
+	insupd := &Insupd{Returning: true}
+	lists, err = insupd.RunAction(db, table, map[string]any{"x": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row = lists[0].(map[string]any)
+	if row["id"] != 1 || row["n"] != 7 {
+		t.Errorf("%#v", row)
+	}
+	lists, err = insupd.RunAction(db, table, map[string]any{"x": "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row = lists[0].(map[string]any)
+	// the upsert of "b" used up id 2, as AUTOINCREMENT does on conflicts
+	if row["id"] != 3 || row["x"] != "c" || row["y"] != "yy" {
+		t.Errorf("%#v", row)
+	}
+
+	// without RETURNING, the row is read back by a pk that must be given
+	if _, err = db.Exec(`CREATE TABLE m_b (code varchar(8) primary key, y varchar(8) not null default 'yy')`); err != nil {
+		t.Fatal(err)
+	}
+	table = &Table{
+		TableName: "m_b",
+		Pks:       []string{"code"},
+		Columns: []*Col{
+			{ColumnName: "code", Label: "code", TypeName: "string"},
+			{ColumnName: "y", Label: "y", TypeName: "string"},
+		},
+	}
+	table.SetDBDriver(SQLRaw)
+	if _, err = insert.RunAction(db, table, map[string]any{"y": "zz"}); !errors.Is(err, ErrMissingKey) {
+		t.Errorf("%v", err)
+	}
+	lists, err = insert.RunAction(db, table, map[string]any{"code": "k"})
+	if err != nil || len(lists) != 1 || lists[0].(map[string]any)["y"] != "yy" {
+		t.Errorf("%v %#v", err, lists)
+	}
+	var n int
+	if err = db.QueryRow(`SELECT COUNT(*) FROM m_b`).Scan(&n); err != nil || n != 1 {
+		t.Errorf("%v %d", err, n)
+	}
+}
+
+func TestActionAffected(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Exec(`INSERT INTO m_a (x) VALUES ('a')`)
+
+	table := &Table{
+		TableName: "m_a",
+		Pks:       []string{"id"},
+		IDAuto:    "id",
+		Columns: []*Col{
+			{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+			{ColumnName: "x", Label: "x", TypeName: "string", Notnull: true},
+		},
+	}
+	table.SetDBDriver(SQLite)
+
+	update := &Update{ROWSAFFECTED: "affected", Strict: true}
+	lists, err := update.RunAction(db, table, map[string]any{"id": 1, "x": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lists[0].(map[string]any)["affected"] != int64(1) {
+		t.Errorf("%#v", lists)
+	}
+	_, err = update.RunAction(db, table, map[string]any{"id": 2, "x": "b"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("%v", err)
+	}
+
+	edit := &Edit{Strict: true}
+	lists, err = edit.RunAction(db, table, map[string]any{"id": 1})
+	if err != nil || lists[0].(map[string]any)["x"] != "b" {
+		t.Errorf("%v %#v", err, lists)
+	}
+	_, err = edit.RunAction(db, table, map[string]any{"id": 2})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("%v", err)
+	}
+
+	// a row matched but not changed, which MySQL does not count as affected
+	if _, err = db.Exec(`CREATE TRIGGER m_a_same BEFORE UPDATE ON m_a WHEN NEW.x = OLD.x BEGIN SELECT RAISE(IGNORE); END`); err != nil {
+		t.Fatal(err)
+	}
+	lists, err = update.RunAction(db, table, map[string]any{"id": 1, "x": "b"})
+	if err != nil || lists[0].(map[string]any)["affected"] != int64(1) {
+		t.Errorf("%v %#v", err, lists)
+	}
+
+	dele := &Delete{ROWSAFFECTED: "affected", Strict: true}
+	lists, err = dele.RunAction(db, table, map[string]any{"id": 1})
+	if err != nil || lists[0].(map[string]any)["affected"] != int64(1) {
+		t.Errorf("%v %#v", err, lists)
+	}
+	_, err = dele.RunAction(db, table, map[string]any{"id": 1})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("%v", err)
+	}
+}
+
+func TestActionQuoted(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`CREATE TABLE "order" (id integer primary key autoincrement, "group" varchar(8) not null, "Select" int)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := &Table{
+		TableName: "order",
+		Pks:       []string{"id"},
+		IDAuto:    "id",
+		Columns: []*Col{
+			{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+			{ColumnName: "group", Label: "group", TypeName: "string", Notnull: true},
+			{ColumnName: "Select", Label: "sel", TypeName: "int"},
+		},
+	}
+	table.SetDBDriver(SQLite)
+
+	insert := &Insert{}
+	if _, err = insert.RunAction(db, table, map[string]any{"group": "a", "sel": 1}); err != nil {
+		t.Fatal(err)
+	}
+	update := &Update{}
+	if _, err = update.RunAction(db, table, map[string]any{"id": 1, "group": "b", "sel": 2}); err != nil {
+		t.Fatal(err)
+	}
+	topics := &Topics{}
+	lists, err := topics.RunAction(db, table, map[string]any{"sortby": "group"}, map[string]any{"group": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists) != 1 || lists[0].(map[string]any)["sel"] != 2 {
+		t.Errorf("%#v", lists)
+	}
+	dele := &Delete{}
+	if _, err = dele.RunAction(db, table, map[string]any{"id": 1}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/genelet/horizon/dethcl"
 	"github.com/genelet/schema"
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 )
 
@@ -18,8 +19,14 @@ import (
 type Atom struct {
 	AtomName string `json:"atomName,omitempty" hcl:"atomName,label"`
 	Table
+	// Timeout bounds each run of an action of the atom, e.g. 500ms or 2s
+	Timeout string       `json:"timeout,omitempty" hcl:"timeout,optional"`
 	Actions []Capability `json:"actions,omitempty" hcl:"actions,block"`
 	customs map[string]any
+	// index of actions by name, built by Molecule.Compile
+	index map[string]Capability
+	// hooks by action name, "*" for all actions
+	hooks map[string][]Hooks
 }
 
 // UnmarshalJSON is a JSON unmarshaler
@@ -27,6 +34,7 @@ func (a *Atom) UnmarshalJSON(bs []byte) error {
 	type m struct {
 		AtomName string `json:"atomName,omitempty"`
 		Table
+		Timeout string           `json:"timeout,omitempty"`
 		Actions []map[string]any `json:"actions,omitempty"`
 	}
 	tmp := &m{}
@@ -35,34 +43,49 @@ func (a *Atom) UnmarshalJSON(bs []byte) error {
 	}
 
 	trans := getEmptyCapacities()
+	var others []Capability
 	for _, action := range tmp.Actions {
 		v, ok := action["actionName"]
 		if !ok {
 			continue
 		}
 		name := v.(string)
+		actionType := name
+		if v, ok := action["actionType"].(string); ok && v != "" {
+			actionType = v
+		}
 		jsonString, err := json.Marshal(action)
 		if err != nil {
 			return err
 		}
+		found := false
 		for i, item := range trans {
-			if name == item.GetBaseAction().ActionName {
+			if name == actionType && name == item.GetBaseAction().ActionName {
 				if err = json.Unmarshal(jsonString, item); err != nil {
 					return err
 				}
-				switch name {
-				case "insert", "update", "insupd", "delete", "delecs":
-					item.GetBaseAction().IsDo = true
-				default:
-				}
 				trans[i] = item
+				found = true
 				break
 			}
 		}
+		if found {
+			continue
+		}
+		// a custom action, or another instance of a built-in type
+		item := newNamedCapability(actionType, name)
+		if item == nil {
+			return errorActionType(name, actionType)
+		}
+		if err = json.Unmarshal(jsonString, item); err != nil {
+			return err
+		}
+		others = append(others, item)
 	}
 
 	a.AtomName = tmp.AtomName
 	a.Table = tmp.Table
+	a.Timeout = tmp.Timeout
 
 	for _, item := range trans {
 		switch item.GetBaseAction().ActionName {
@@ -72,10 +95,40 @@ func (a *Atom) UnmarshalJSON(bs []byte) error {
 		}
 	}
 
-	a.Actions = trans
+	a.Actions = append(trans, others...)
 	return nil
 }
 
+// MarshalJSON is a JSON marshaler, which writes the type of an action
+// if different from its name
+func (a *Atom) MarshalJSON() ([]byte, error) {
+	type m struct {
+		AtomName string `json:"atomName,omitempty"`
+		Table
+		Timeout string       `json:"timeout,omitempty"`
+		Actions []Capability `json:"actions,omitempty"`
+	}
+	return json.Marshal(&m{AtomName: a.AtomName, Table: a.Table, Timeout: a.Timeout, Actions: a.typedActions()})
+}
+
+// typedActions returns the actions, where those named differently from
+// their types are copied to set ActionType
+func (a *Atom) typedActions() []Capability {
+	if a.Actions == nil {
+		return nil
+	}
+	actions := make([]Capability, len(a.Actions))
+	for i, action := range a.Actions {
+		base := action.GetBaseAction()
+		if actionType := ActionTypeOf(action); actionType != base.ActionName && base.ActionType == "" {
+			action = copyCapability(action)
+			action.GetBaseAction().ActionType = actionType
+		}
+		actions[i] = action
+	}
+	return actions
+}
+
 // UnmarshalHCL is a HCL unmarshaler
 func (a *Atom) UnmarshalHCL(bs []byte, labels ...string) error {
 	file, diags := hclsyntax.ParseConfig(bs, rname(), hcl.Pos{Line: 1, Column: 1})
@@ -98,6 +151,10 @@ func (a *Atom) UnmarshalHCL(bs []byte, labels ...string) error {
 func specFromAtomBody(body *hclsyntax.Body, customs map[string]any) (*schema.Struct, map[string]any, error) {
 	ref := map[string]any{"Connection": new(Connection)}
 	accepted := make(map[string]bool)
+	for k, v := range registeredCapabilities() {
+		ref[k] = v
+		accepted[k] = true
+	}
 	for k, v := range customs {
 		ref[k] = v
 		accepted[k] = true
@@ -116,8 +173,13 @@ func specFromAtomBody(body *hclsyntax.Body, customs map[string]any) (*schema.Str
 			return nil, nil, fmt.Errorf("HCL finds no actionName from actions")
 		}
 		key := block.Labels[0]
+		if attr, ok := block.Body.Attributes["actionType"]; ok {
+			if diags := gohcl.DecodeExpression(attr.Expr, nil, &key); diags.HasErrors() {
+				return nil, nil, diags
+			}
+		}
 		if _, ok := accepted[key]; !ok {
-			continue
+			return nil, nil, errorActionType(block.Labels[0], key)
 		}
 
 		var nextpages, prepares []string
@@ -173,11 +235,9 @@ func (a *Atom) updateDefaultActions() {
 			a.Actions = append(a.Actions, v)
 		}
 	}
-	for i, v := range a.Actions {
-		switch v.GetBaseAction().ActionName {
-		case "insert", "update", "insupd", "delete", "delecs":
-			a.Actions[i].GetBaseAction().IsDo = true
-		default:
+	for _, v := range a.Actions {
+		if c := NewCapability(ActionTypeOf(v)); c != nil {
+			v.GetBaseAction().IsDo = c.GetBaseAction().IsDo
 		}
 	}
 }
@@ -222,6 +282,9 @@ func (a *Atom) MergeCustomActions(custom ...Capability) {
 
 // GetAction gets a specific action by name
 func (a *Atom) GetAction(actionName string) Capability {
+	if a.index != nil {
+		return a.index[actionName]
+	}
 	for _, item := range a.Actions {
 		if item.GetBaseAction().ActionName == actionName {
 			return item
@@ -236,23 +299,52 @@ func (a *Atom) RunAtom(db *sql.DB, action string, args any, extra ...map[string]
 	return a.RunAtomContext(context.Background(), db, action, args, extra...)
 }
 
-// RunAtomContext runs an action with context by name
+// RunAtomContext runs an action with context by name, bounded by Timeout
+// of the atom. The hooks of the action run around it for each row of args.
 func (a *Atom) RunAtomContext(ctx context.Context, db *sql.DB, action string, args any, extra ...map[string]any) ([]any, error) {
+	return withTimeout(ctx, a.AtomName, a.Timeout, func(ctx context.Context) ([]any, error) {
+		return a.runAtomContext(ctx, db, action, args, a.hooksOf(action), extra...)
+	})
+}
+
+func (a *Atom) runAtomContext(ctx context.Context, db *sql.DB, action string, args any, hooks []Hooks, extra ...map[string]any) ([]any, error) {
 	obj := a.GetAction(action)
 	if obj == nil {
 		return nil, errorActionNil(action)
 	}
+	run := func(args map[string]any) ([]any, error) {
+		if hooks == nil {
+			return obj.RunActionContext(ctx, db, &a.Table, args, extra...)
+		}
+		var first map[string]any
+		if len(extra) > 0 {
+			first = extra[0]
+		}
+		args, first, err := a.runBefore(ctx, action, hooks, args, first)
+		if err != nil {
+			return nil, err
+		}
+		var newExtra []map[string]any
+		if first != nil || len(extra) > 1 {
+			newExtra = append([]map[string]any{first}, extra[min(1, len(extra)):]...)
+		}
+		lists, err := obj.RunActionContext(ctx, db, &a.Table, args, newExtra...)
+		if err != nil {
+			return nil, err
+		}
+		return lists, a.runAfter(ctx, action, hooks, args, lists)
+	}
 	if args == nil {
-		return obj.RunActionContext(ctx, db, &a.Table, nil, extra...)
+		return run(nil)
 	}
 
 	switch t := args.(type) {
 	case map[string]any:
-		return obj.RunActionContext(ctx, db, &a.Table, t, extra...)
+		return run(t)
 	case []map[string]any:
 		var data []any
 		for _, item := range t {
-			lists, err := obj.RunActionContext(ctx, db, &a.Table, item, extra...)
+			lists, err := run(item)
 			if err != nil {
 				return nil, err
 			}
@@ -263,7 +355,7 @@ func (a *Atom) RunAtomContext(ctx context.Context, db *sql.DB, action string, ar
 		var data []any
 		for _, item := range t {
 			if args, ok := item.(map[string]any); ok {
-				lists, err := obj.RunActionContext(ctx, db, &a.Table, args, extra...)
+				lists, err := run(args)
 				if err != nil {
 					return nil, err
 				}
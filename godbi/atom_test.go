@@ -88,6 +88,10 @@ type SQL struct {
 	Statement string `json:"statement"`
 }
 
+func init() {
+	RegisterCapability("sql", &SQL{})
+}
+
 func (s *SQL) RunActionContext(ctx context.Context, db *sql.DB, t *Table, args map[string]any, extra ...map[string]any) ([]any, error) {
 	lists := make([]any, 0)
 	dbi := &DBI{DB: db}
@@ -146,7 +150,7 @@ func TestAtomRun(t *testing.T) {
 
 	db.Exec(`drop table if exists m_a`)
 	db.Exec(`CREATE TABLE m_a (id int auto_increment not null primary key,
-        x varchar(8), y varchar(8), z varchar(8))`)
+        x varchar(8), y varchar(8), z varchar(8), UNIQUE (x, y))`)
 
 	str := `{
     "tableName":"m_a",
@@ -260,7 +264,7 @@ func TestAtomRunMultiple(t *testing.T) {
 
 	db.Exec(`drop table if exists m_a`)
 	db.Exec(`CREATE TABLE m_a (id int auto_increment not null primary key,
-        x varchar(8), y varchar(8), z varchar(8))`)
+        x varchar(8), y varchar(8), z varchar(8), UNIQUE (x, y))`)
 
 	str := `{
     "tableName":"m_a",
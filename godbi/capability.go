@@ -0,0 +1,125 @@
+package godbi
+
+import (
+	"sync"
+)
+
+var (
+	capabilityMu sync.RWMutex
+	capabilities = make(map[string]Capability)
+)
+
+// RegisterCapability registers the custom action type by name, so that
+// atoms loaded from JSON, HCL or gometa.Graph create it for the actions
+// of the type. The prototype is copied for each action, so it may carry
+// defaults, e.g. IsDo for an action changing data. Registering an existing
+// name replaces it, and registering a built-in name panics.
+func RegisterCapability(name string, prototype Capability) {
+	if prototype == nil {
+		panic("godbi: RegisterCapability prototype is nil")
+	}
+	if isBuiltinType(name) {
+		panic("godbi: RegisterCapability of built-in action " + name)
+	}
+	capabilityMu.Lock()
+	defer capabilityMu.Unlock()
+	capabilities[name] = prototype
+}
+
+// NewCapability returns a new action of the built-in or registered type,
+// named after the type, or nil if the type is unknown
+func NewCapability(actionType string) Capability {
+	for _, v := range getEmptyCapacities() {
+		if v.GetBaseAction().ActionName == actionType {
+			v.GetBaseAction().IsDo = isDoType(actionType)
+			return v
+		}
+	}
+
+	capabilityMu.RLock()
+	prototype, ok := capabilities[actionType]
+	capabilityMu.RUnlock()
+	if !ok {
+		return nil
+	}
+	action := copyCapability(prototype)
+	action.GetBaseAction().ActionName = actionType
+	return action
+}
+
+// newNamedCapability returns a new action of the type named name
+func newNamedCapability(actionType, name string) Capability {
+	action := NewCapability(actionType)
+	if action == nil {
+		return nil
+	}
+	if name != actionType {
+		action.GetBaseAction().ActionName = name
+		action.GetBaseAction().ActionType = actionType
+	}
+	return action
+}
+
+// ActionTypeOf returns the type of the action. The type of a built-in
+// action is decided by its Go type.
+func ActionTypeOf(action Capability) string {
+	switch action.(type) {
+	case *Insert:
+		return "insert"
+	case *Update:
+		return "update"
+	case *Insupd:
+		return "insupd"
+	case *Delete:
+		return "delete"
+	case *Delecs:
+		return "delecs"
+	case *Topics:
+		return "topics"
+	case *Edit:
+		return "edit"
+	case *Stmt:
+		return "stmt"
+	default:
+	}
+	base := action.GetBaseAction()
+	if base.ActionType != "" {
+		return base.ActionType
+	}
+	return base.ActionName
+}
+
+func isBuiltinType(actionType string) bool {
+	for _, v := range getEmptyCapacities() {
+		if v.GetBaseAction().ActionName == actionType {
+			return true
+		}
+	}
+	return false
+}
+
+// isDoType tells if the built-in type changes data
+func isDoType(actionType string) bool {
+	switch actionType {
+	case "insert", "update", "insupd", "delete", "delecs":
+		return true
+	default:
+	}
+	return false
+}
+
+// registeredCapabilities returns new actions of all registered types
+func registeredCapabilities() map[string]any {
+	capabilityMu.RLock()
+	names := make([]string, 0, len(capabilities))
+	for name := range capabilities {
+		names = append(names, name)
+	}
+	capabilityMu.RUnlock()
+
+	customs := make(map[string]any)
+	for _, name := range names {
+		customs[name] = NewCapability(name)
+	}
+	return customs
+}
@@ -0,0 +1,216 @@
+package godbi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/genelet/horizon/dethcl"
+)
+
+// counter counts rows of the table, or those of a column value
+type counter struct {
+	Action
+	Column string `json:"column,omitempty" hcl:"column,optional"`
+}
+
+func (c *counter) RunActionContext(ctx context.Context, db *sql.DB, t *Table, args map[string]any, extra ...map[string]any) ([]any, error) {
+	lists := make([]any, 0)
+	str := "SELECT COUNT(*) FROM " + t.quotedName()
+	var vals []any
+	if v, ok := args[c.Column]; ok {
+		str += " WHERE " + t.quote(c.Column) + "=?"
+		vals = append(vals, v)
+	}
+	err := t.dbi(db).SelectSQLContext(ctx, &lists, str, []any{[2]string{"total", "int"}}, vals...)
+	return lists, err
+}
+
+func init() {
+	RegisterCapability("count", &counter{})
+}
+
+func TestCapabilityRegistry(t *testing.T) {
+	atom := new(Atom)
+	err := json.Unmarshal([]byte(`{"tableName":"m_a","pks":["id"],"columns":[{"columnName":"x","typeName":"string","label":"x"}],"actions":[{"actionName":"count","column":"x"}]}`), atom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ok := atom.GetAction("count").(*counter)
+	if !ok || c.Column != "x" {
+		t.Errorf("%#v", atom.Actions)
+	}
+
+	// an action of an unregistered type is an error, not dropped
+	err = json.Unmarshal([]byte(`{"tableName":"m_a","pks":["id"],"actions":[{"actionName":"unknown"}]}`), new(Atom))
+	if !errors.Is(err, ErrActionNotFound) {
+		t.Errorf("%v", err)
+	}
+	err = json.Unmarshal([]byte(`{"tableName":"m_a","pks":["id"],"actions":[{"actionName":"total","actionType":"unknown"}]}`), new(Atom))
+	if !errors.Is(err, ErrActionNotFound) || !strings.Contains(err.Error(), "total") {
+		t.Errorf("%v", err)
+	}
+	err = dethcl.Unmarshal([]byte(`
+tableName = "m_a"
+pks = ["id"]
+actions unknown {
+}
+`), new(Atom))
+	if !errors.Is(err, ErrActionNotFound) {
+		t.Errorf("%v", err)
+	}
+
+	bs, err := json.Marshal(atom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	atom = new(Atom)
+	if err = json.Unmarshal(bs, atom); err != nil {
+		t.Fatal(err)
+	}
+	if c, ok := atom.GetAction("count").(*counter); !ok || c.Column != "x" {
+		t.Errorf("%s", bs)
+	}
+
+	atom = new(Atom)
+	err = dethcl.Unmarshal([]byte(`
+tableName = "m_a"
+pks = ["id"]
+actions count {
+  column = "x"
+}
+`), atom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, ok := atom.GetAction("count").(*counter); !ok || c.Column != "x" {
+		t.Errorf("%#v", atom.Actions)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("built-in action registered")
+		}
+	}()
+	RegisterCapability("insert", &counter{})
+}
+
+func TestCapabilityRun(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`,
+		`INSERT INTO m_a (x) VALUES ('a'), ('a'), ('b')`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	molecule := new(Molecule)
+	err = json.Unmarshal([]byte(`{"dbDriver":1,"atoms":[{"atomName":"m_a","tableName":"m_a","pks":["id"],"columns":[{"columnName":"id","typeName":"int","label":"id"},{"columnName":"x","typeName":"string","label":"x"}],"actions":[{"actionName":"count","column":"x"}]}]}`), molecule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lists, err := molecule.RunContext(context.Background(), db, "m_a", "count", &RunOption{Args: map[string]any{"x": "a"}})
+	if err != nil || len(lists) != 1 || lists[0].(map[string]any)["total"] != 2 {
+		t.Errorf("%v %#v", err, lists)
+	}
+}
+
+func TestActionType(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null, y varchar(8))`,
+		`INSERT INTO m_a (x, y) VALUES ('a', 'b'), ('c', 'd')`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	check := func(atom *Atom) {
+		if _, ok := atom.GetAction("listX").(*Topics); !ok {
+			t.Fatalf("%#v", atom.Actions)
+		}
+		if _, ok := atom.GetAction("topics").(*Topics); !ok {
+			t.Fatalf("%#v", atom.Actions)
+		}
+		if a, ok := atom.GetAction("put").(*Insert); !ok || !a.IsDo {
+			t.Fatalf("%#v", atom.Actions)
+		}
+		molecule := &Molecule{Atoms: []*Atom{atom}, DBDriver: SQLite}
+		lists, err := molecule.RunContext(context.Background(), db, "m_a", "listX", nil)
+		if err != nil || len(lists) != 2 || len(lists[0].(map[string]any)) != 1 {
+			t.Errorf("%v %#v", err, lists)
+		}
+		lists, err = molecule.RunContext(context.Background(), db, "m_a", "topics", nil)
+		if err != nil || len(lists) != 2 || len(lists[0].(map[string]any)) != 3 {
+			t.Errorf("%v %#v", err, lists)
+		}
+	}
+
+	atom := new(Atom)
+	err = json.Unmarshal([]byte(`{"atomName":"m_a","tableName":"m_a","pks":["id"],"idAuto":"id","columns":[{"columnName":"id","typeName":"int","label":"id","auto":true},{"columnName":"x","typeName":"string","label":"x"},{"columnName":"y","typeName":"string","label":"y"}],"actions":[{"actionName":"listX","actionType":"topics","picked":["x"]},{"actionName":"put","actionType":"insert"}]}`), atom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(atom)
+
+	bs, err := json.Marshal(atom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	atom = new(Atom)
+	if err = json.Unmarshal(bs, atom); err != nil {
+		t.Fatal(err)
+	}
+	check(atom)
+
+	atom = new(Atom)
+	err = dethcl.Unmarshal([]byte(`
+atomName = "m_a"
+tableName = "m_a"
+pks = ["id"]
+idAuto = "id"
+columns id {
+  typeName = "int"
+  columnLabel = "id"
+  auto = true
+}
+columns x {
+  typeName = "string"
+  columnLabel = "x"
+}
+columns y {
+  typeName = "string"
+  columnLabel = "y"
+}
+actions listX {
+  actionType = "topics"
+  picked = ["x"]
+}
+actions put {
+  actionType = "insert"
+}
+`), atom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	atom.AtomName = "m_a"
+	check(atom)
+}
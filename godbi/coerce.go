@@ -0,0 +1,245 @@
+package godbi
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayouts are the layouts tried, in order, when coercing a string into time
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// intRanges are the minimum and maximum values of the integer types
+// narrower than int64
+var intRanges = map[string][2]int64{
+	"int":    {math.MinInt, math.MaxInt},
+	"int8":   {math.MinInt8, math.MaxInt8},
+	"int16":  {math.MinInt16, math.MaxInt16},
+	"int24":  {-1 << 23, 1<<23 - 1},
+	"int32":  {math.MinInt32, math.MaxInt32},
+	"uint8":  {0, math.MaxUint8},
+	"uint16": {0, math.MaxUint16},
+	"uint32": {0, math.MaxUint32},
+}
+
+// coerceValue converts v, usually decoded from JSON, into the GO type
+// named by typeName, as used in Col.TypeName. Unknown type names and
+// nil values are returned unchanged.
+func coerceValue(typeName string, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if n, ok := v.(json.Number); ok {
+		v = n.String()
+	}
+
+	switch typeName {
+	case "int", "int8", "int16", "int24", "int32", "int64":
+		i, err := toInt64(v)
+		if err != nil {
+			return nil, err
+		}
+		if r, ok := intRanges[typeName]; ok && (i < r[0] || i > r[1]) {
+			return nil, strconv.ErrRange
+		}
+		switch typeName {
+		case "int":
+			return int(i), nil
+		case "int8":
+			return int8(i), nil
+		case "int16":
+			return int16(i), nil
+		case "int32":
+			return int32(i), nil
+		default:
+		}
+		return i, nil
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		i, err := toInt64(v)
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 {
+			return nil, strconv.ErrRange
+		}
+		if r, ok := intRanges[typeName]; ok && i > r[1] {
+			return nil, strconv.ErrRange
+		}
+		switch typeName {
+		case "uint":
+			return uint(i), nil
+		case "uint8":
+			return uint8(i), nil
+		case "uint16":
+			return uint16(i), nil
+		case "uint32":
+			return uint32(i), nil
+		default:
+		}
+		return uint64(i), nil
+	case "float32", "float64", "real":
+		f, err := toFloat64(v)
+		if err != nil {
+			return nil, err
+		}
+		if typeName == "float32" {
+			return float32(f), nil
+		}
+		return f, nil
+	case "bool":
+		return toBool(v)
+	case "time":
+		return toTime(v)
+	case "string":
+		return toString(v)
+	case "[]byte":
+		switch t := v.(type) {
+		case []byte:
+			return t, nil
+		case string:
+			return []byte(t), nil
+		default:
+		}
+		return nil, strconv.ErrSyntax
+	default:
+	}
+	return v, nil
+}
+
+func toInt64(v any) (int64, error) {
+	switch t := v.(type) {
+	case int:
+		return int64(t), nil
+	case int8:
+		return int64(t), nil
+	case int16:
+		return int64(t), nil
+	case int32:
+		return int64(t), nil
+	case int64:
+		return t, nil
+	case uint:
+		return int64(t), nil
+	case uint8:
+		return int64(t), nil
+	case uint16:
+		return int64(t), nil
+	case uint32:
+		return int64(t), nil
+	case uint64:
+		if t > math.MaxInt64 {
+			return 0, strconv.ErrRange
+		}
+		return int64(t), nil
+	case float32:
+		return floatToInt64(float64(t))
+	case float64:
+		return floatToInt64(t)
+	case string:
+		s := strings.TrimSpace(t)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, err
+		}
+		return floatToInt64(f)
+	default:
+	}
+	return 0, strconv.ErrSyntax
+}
+
+func floatToInt64(f float64) (int64, error) {
+	if f != math.Trunc(f) || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, strconv.ErrSyntax
+	}
+	if f > math.MaxInt64 || f < math.MinInt64 {
+		return 0, strconv.ErrRange
+	}
+	return int64(f), nil
+}
+
+func toFloat64(v any) (float64, error) {
+	switch t := v.(type) {
+	case float32:
+		return float64(t), nil
+	case float64:
+		return t, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(t), 64)
+	default:
+	}
+	i, err := toInt64(v)
+	return float64(i), err
+}
+
+func toBool(v any) (bool, error) {
+	switch t := v.(type) {
+	case bool:
+		return t, nil
+	case string:
+		return strconv.ParseBool(strings.TrimSpace(t))
+	default:
+	}
+	i, err := toInt64(v)
+	if err != nil {
+		return false, err
+	}
+	switch i {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	default:
+	}
+	return false, strconv.ErrSyntax
+}
+
+func toTime(v any) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case string:
+		s := strings.TrimSpace(t)
+		for _, layout := range timeLayouts {
+			if x, err := time.Parse(layout, s); err == nil {
+				return x, nil
+			}
+		}
+	default:
+	}
+	return time.Time{}, strconv.ErrSyntax
+}
+
+func toString(v any) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case []byte:
+		return string(t), nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case float32:
+		return strconv.FormatFloat(float64(t), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	case time.Time:
+		return t.Format(time.RFC3339Nano), nil
+	default:
+	}
+	i, err := toInt64(v)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(i, 10), nil
+}
@@ -0,0 +1,143 @@
+package godbi
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCoerceValue(t *testing.T) {
+	cases := []struct {
+		typeName string
+		in       any
+		out      any
+	}{
+		{"int", "42", 42},
+		{"int", 42.0, 42},
+		{"int64", " 42 ", int64(42)},
+		{"int16", float64(7), int16(7)},
+		{"int8", -128, int8(-128)},
+		{"int8", "127", int8(127)},
+		{"uint8", 255, uint8(255)},
+		{"uint32", "4294967295", uint32(4294967295)},
+		{"float64", "1.5", 1.5},
+		{"float32", 2, float32(2)},
+		{"bool", "true", true},
+		{"bool", 0.0, false},
+		{"string", 12.0, "12"},
+		{"string", "abc", "abc"},
+		{"time", "2023-01-02", time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"", 3.0, 3.0},
+		{"int", nil, nil},
+	}
+	for _, c := range cases {
+		got, err := coerceValue(c.typeName, c.in)
+		if err != nil {
+			t.Errorf("%s %v: %v", c.typeName, c.in, err)
+			continue
+		}
+		if x, ok := got.(time.Time); ok {
+			if !x.Equal(c.out.(time.Time)) {
+				t.Errorf("%s %v => %v", c.typeName, c.in, got)
+			}
+			continue
+		}
+		if got != c.out {
+			t.Errorf("%s %v => %#v, %#v wanted", c.typeName, c.in, got, c.out)
+		}
+	}
+
+	for _, c := range [][2]any{{"int", 4.2}, {"int", "abc"}, {"bool", "maybe"}, {"time", "yesterday"}, {"uint", -1}} {
+		if _, err := coerceValue(c[0].(string), c[1]); err == nil {
+			t.Errorf("%v should fail", c)
+		}
+	}
+
+	// out of range values are not truncated
+	for _, c := range [][2]any{{"int8", 300}, {"int8", -129}, {"int16", "40000"}, {"int24", 1 << 23}, {"int32", 1 << 31}, {"int32", -1<<31 - 1}, {"uint8", 256}, {"uint16", 70000.0}, {"uint32", int64(1) << 32}} {
+		if v, err := coerceValue(c[0].(string), c[1]); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("%v: %#v %v", c, v, err)
+		}
+	}
+}
+
+func TestTableGetFv(t *testing.T) {
+	table := &Table{
+		TableName: "m_a",
+		Columns: []*Col{
+			{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+			{ColumnName: "x", Label: "x", TypeName: "int", Notnull: true},
+			{ColumnName: "y", Label: "y", TypeName: "bool"},
+		},
+	}
+	table.SetDBDriver(SQLite)
+	fv, _, err := table.getFv(map[string]any{"id": 1.0, "x": "42", "y": "true"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fv["id"]; ok || fv["x"] != 42 || fv["y"] != 1 {
+		t.Errorf("%#v", fv)
+	}
+
+	if _, _, err = table.getFv(map[string]any{"x": "forty"}, nil); err == nil {
+		t.Errorf("unparseable value accepted")
+	}
+}
+
+func TestTableCoerceWhere(t *testing.T) {
+	table := &Table{
+		TableName: "m_a",
+		Pks:       []string{"id"},
+		Columns: []*Col{
+			{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+			{ColumnName: "x", Label: "x", TypeName: "string", Notnull: true},
+		},
+	}
+	table.SetDBDriver(SQLite)
+	ids, err := table.coerceIDs([]any{"42"})
+	if err != nil || ids[0] != 42 {
+		t.Errorf("%#v %v", ids, err)
+	}
+	ids, err = table.coerceIDs([]any{[]any{"1", json.Number("2")}})
+	if err != nil || ids[0].([]any)[0] != 1 || ids[0].([]any)[1] != 2 {
+		t.Errorf("%#v %v", ids, err)
+	}
+	extra, err := table.coerceExtra(map[string]any{"id": "7", "x": "c", "other": "8"})
+	if err != nil || extra[0]["id"] != 7 || extra[0]["x"] != "c" || extra[0]["other"] != "8" {
+		t.Errorf("%#v %v", extra, err)
+	}
+	if _, err = table.coerceIDs([]any{"forty"}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("%v", err)
+	}
+
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	if _, err = db.Exec(`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`); err != nil {
+		t.Fatal(err)
+	}
+	db.Exec(`INSERT INTO m_a (x) VALUES ('a')`)
+
+	edit := &Edit{Strict: true}
+	lists, err := edit.RunAction(db, table, map[string]any{"id": "1"})
+	if err != nil || lists[0].(map[string]any)["x"] != "a" {
+		t.Errorf("%v %#v", err, lists)
+	}
+	update := &Update{ROWSAFFECTED: "affected"}
+	lists, err = update.RunAction(db, table, map[string]any{"id": json.Number("1"), "x": "b"}, map[string]any{"id": "1"})
+	if err != nil || lists[0].(map[string]any)["affected"] != int64(1) {
+		t.Errorf("%v %#v", err, lists)
+	}
+	dele := &Delete{}
+	if _, err = dele.RunAction(db, table, map[string]any{"id": "forty"}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("%v", err)
+	}
+	if _, err = dele.RunAction(db, table, map[string]any{"id": 1}, map[string]any{"id": "one"}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("%v", err)
+	}
+}
@@ -0,0 +1,148 @@
+package godbi
+
+import (
+	"reflect"
+)
+
+// Compile returns a copy of the molecule ready to run, which is immutable
+// and safe to share across goroutines. In the copy, atoms and actions are
+// indexed by name, the dialect and logger are set once, the default names
+// of action arguments are filled in, and the SELECT clause of each action
+// is precomputed. Connections to unknown atoms or actions, and timeouts
+// not of durations, are errors.
+//
+// The copy holds its own tables and connections, so m may be changed
+// after compiled, without changing the copy.
+func (m *Molecule) Compile() (*Molecule, error) {
+	dialect := m.getDialect()
+	if _, err := parseTimeout("molecule", m.Timeout); err != nil {
+		return nil, err
+	}
+	compiled := &Molecule{
+		DBDriver:   m.DBDriver,
+		Dialect:    m.Dialect,
+		Timeout:    m.Timeout,
+		Limits:     m.Limits,
+		Stopper:    m.Stopper,
+		PreStopper: m.PreStopper,
+		logger:     m.logger,
+		cache:      m.cache,
+		results:    m.results,
+		ttls:       m.ttls,
+		retry:      m.retry,
+		index:      make(map[string]*Atom),
+	}
+
+	for _, a := range m.Atoms {
+		if _, ok := compiled.index[a.AtomName]; ok {
+			return nil, errorDuplicateAtom(a.AtomName)
+		}
+		if _, err := parseTimeout(a.AtomName, a.Timeout); err != nil {
+			return nil, err
+		}
+		atom := &Atom{AtomName: a.AtomName, Table: *copyTable(&a.Table), Timeout: a.Timeout, customs: a.customs, index: make(map[string]Capability), hooks: a.hooks}
+		atom.Table.dialect = dialect
+		atom.Table.logger = m.logger
+		atom.Table.cache = m.cache
+		for _, action := range a.Actions {
+			action = copyCapability(action)
+			base := action.GetBaseAction()
+			base.Prepares = copyConnections(base.Prepares)
+			base.Nextpages = copyConnections(base.Nextpages)
+			if _, ok := atom.index[base.ActionName]; ok {
+				return nil, errorDuplicateAction(base.ActionName, a.AtomName)
+			}
+			if x, ok := action.(interface{ setDefaultElementNames() []string }); ok {
+				x.setDefaultElementNames()
+			}
+			base.selectSQL, base.selectLabels = atom.Table.filterPars(nil, "", base.getAllowed())
+			base.selectTable = &atom.Table
+			atom.Actions = append(atom.Actions, action)
+			atom.index[base.ActionName] = action
+		}
+		compiled.Atoms = append(compiled.Atoms, atom)
+		compiled.index[atom.AtomName] = atom
+	}
+
+	for _, atom := range compiled.Atoms {
+		for _, action := range atom.Actions {
+			base := action.GetBaseAction()
+			if _, err := parseTimeout(atom.AtomName+"."+base.ActionName, base.Timeout); err != nil {
+				return nil, err
+			}
+			for _, connections := range [][]*Connection{base.Prepares, base.Nextpages} {
+				for _, c := range connections {
+					target, ok := compiled.index[c.AtomName]
+					if !ok {
+						return nil, errorAtomNotFound(c.AtomName)
+					}
+					if _, ok = target.index[c.ActionName]; !ok {
+						return nil, errorActionNotFound(c.ActionName, c.AtomName)
+					}
+					if _, err := parseTimeout(c.AtomName+"."+c.ActionName, c.Timeout); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	return compiled, nil
+}
+
+// copyCapability returns a shallow copy of the action
+func copyCapability(action Capability) Capability {
+	v := reflect.ValueOf(action)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return action
+	}
+	obj := reflect.New(v.Elem().Type())
+	obj.Elem().Set(v.Elem())
+	return obj.Interface().(Capability)
+}
+
+// copyTable returns a copy of the table with its own columns and keys
+func copyTable(t *Table) *Table {
+	table := *t
+	table.Columns = nil
+	for _, col := range t.Columns {
+		c := *col
+		table.Columns = append(table.Columns, &c)
+	}
+	table.Pks = append([]string(nil), t.Pks...)
+	table.Uniques = append([]string(nil), t.Uniques...)
+	table.Fks = nil
+	for _, fk := range t.Fks {
+		f := *fk
+		f.FkColumns = append([]string(nil), fk.FkColumns...)
+		f.Columns = append([]string(nil), fk.Columns...)
+		table.Fks = append(table.Fks, &f)
+	}
+	return &table
+}
+
+// copyConnections returns copies of the connections
+func copyConnections(connections []*Connection) []*Connection {
+	if connections == nil {
+		return nil
+	}
+	copies := make([]*Connection, len(connections))
+	for i, c := range connections {
+		connection := *c
+		connection.RelateArgs = copyRelate(c.RelateArgs)
+		connection.RelateExtra = copyRelate(c.RelateExtra)
+		copies[i] = &connection
+	}
+	return copies
+}
+
+func copyRelate(relate map[string]string) map[string]string {
+	if relate == nil {
+		return nil
+	}
+	hash := make(map[string]string, len(relate))
+	for k, v := range relate {
+		hash[k] = v
+	}
+	return hash
+}
@@ -1,5 +1,11 @@
 package godbi
 
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
 type ConnectType int
 
 const (
@@ -10,6 +16,46 @@ const (
 	CONNECTMany
 )
 
+var connectTypeNames = []string{"default", "one", "array", "map", "many"}
+
+// String returns the name of the ConnectType, e.g. one or many
+func (c ConnectType) String() string {
+	if c < 0 || int(c) >= len(connectTypeNames) {
+		return strconv.Itoa(int(c))
+	}
+	return connectTypeNames[c]
+}
+
+// ConnectTypeByName returns the ConnectType by name, e.g. one or many,
+// and false if the name is unknown
+func ConnectTypeByName(name string) (ConnectType, bool) {
+	for i, v := range connectTypeNames {
+		if strings.EqualFold(name, v) || strings.EqualFold(name, "CONNECT"+v) {
+			return ConnectType(i), true
+		}
+	}
+	return CONNECTDefault, false
+}
+
+// UnmarshalJSON accepts both the number and the name of ConnectType
+func (c *ConnectType) UnmarshalJSON(bs []byte) error {
+	var name string
+	if err := json.Unmarshal(bs, &name); err != nil {
+		var i int
+		if err = json.Unmarshal(bs, &i); err != nil {
+			return err
+		}
+		*c = ConnectType(i)
+		return nil
+	}
+	v, ok := ConnectTypeByName(name)
+	if !ok {
+		return errorConnectType(name)
+	}
+	*c = v
+	return nil
+}
+
 // Connection describes linked page
 // 1) for Nextpages, it maps item in lists to next ARGS and next Extra
 // 2) for Prepares, it maps current ARGS to the next ARGS and next Extra
@@ -31,6 +77,12 @@ type Connection struct {
 
 	// Marker: for input data, this marks a whole data set for the next or previous object; for output data, this is the key for the next whole data set.
 	Marker string `json:"marker,omitempty" hcl:"marker,optional"`
+
+	// Timeout: bounds the run of the connected action, e.g. 500ms or 2s
+	Timeout string `json:"timeout,omitempty" hcl:"timeout,optional"`
+
+	// WithRecursive: for a nextpage of a recursive table to itself, reads the whole subtree in one WITH RECURSIVE query, instead of one query per node
+	WithRecursive bool `json:"withRecursive,omitempty" hcl:"withRecursive,optional"`
 }
 
 // Subname is the marker string used to store the output
@@ -0,0 +1,276 @@
+package godbi
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// The kinds of constraint violations, to be tested by errors.Is.
+// errors.Is(err, ErrConstraint) tells if err is any of them.
+var (
+	ErrConstraint          = errors.New("constraint violated")
+	ErrUniqueViolation     = errors.New("unique constraint violated")
+	ErrForeignKeyViolation = errors.New("foreign key constraint violated")
+	ErrNotNullViolation    = errors.New("not null constraint violated")
+	ErrCheckViolation      = errors.New("check constraint violated")
+)
+
+// ConstraintError is a constraint violation reported by the database driver,
+// translated by the dialect. The columns not reported by the driver are
+// matched against the primary key, Uniques and Fks of the table.
+type ConstraintError struct {
+	// Kind is one of ErrUniqueViolation, ErrForeignKeyViolation,
+	// ErrNotNullViolation and ErrCheckViolation
+	Kind error
+	// Table is the table reported, or else the table being changed
+	Table string
+	// Constraint is the name of the constraint, if reported
+	Constraint string
+	// Columns are the columns of the constraint, if known
+	Columns []string
+	// Err is the driver error
+	Err error
+}
+
+func (e *ConstraintError) Error() string {
+	str := e.Kind.Error() + " on " + e.Table
+	if len(e.Columns) > 0 {
+		str += "(" + strings.Join(e.Columns, ", ") + ")"
+	}
+	if e.Constraint != "" {
+		str += " by " + e.Constraint
+	}
+	return str + ": " + e.Err.Error()
+}
+
+// Is reports if target is the kind of the violation, or ErrConstraint
+func (e *ConstraintError) Is(target error) bool {
+	return target == e.Kind || target == ErrConstraint
+}
+
+// Unwrap returns the driver error
+func (e *ConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// ConstraintParser is implemented by a dialect recognizing constraint
+// violations in the errors of its driver. The built-in dialects read
+// the messages of the drivers, so no driver package is imported.
+type ConstraintParser interface {
+	// ParseConstraint returns the violation in err, or nil if err is not
+	ParseConstraint(err error) *ConstraintError
+}
+
+// constraintRule matches a driver message. The indices are of the
+// submatches of table, constraint and columns, 0 if not in the message.
+type constraintRule struct {
+	kind       error
+	re         *regexp.Regexp
+	table      int
+	constraint int
+	columns    int
+}
+
+func parseConstraint(rules []constraintRule, err error) *ConstraintError {
+	msg := err.Error()
+	for _, rule := range rules {
+		match := rule.re.FindStringSubmatch(msg)
+		if match == nil {
+			continue
+		}
+		ce := &ConstraintError{Kind: rule.kind, Err: err}
+		if rule.table > 0 {
+			ce.Table = unquoteName(match[rule.table])
+		}
+		if rule.constraint > 0 {
+			ce.Constraint = unquoteName(match[rule.constraint])
+		}
+		if rule.columns > 0 {
+			for _, name := range strings.Split(match[rule.columns], ",") {
+				name = strings.TrimSpace(name)
+				// sqlite reports columns as table.column
+				if i := strings.LastIndex(name, "."); i > 0 && ce.Table == "" {
+					ce.Table = unquoteName(name[:i])
+				}
+				ce.Columns = append(ce.Columns, unquoteName(name))
+			}
+		}
+		return ce
+	}
+	return nil
+}
+
+// unquoteName returns the last part of a dotted name without quotes
+func unquoteName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.Trim(name, "`\"'[] ")
+}
+
+var sqliteConstraints = []constraintRule{
+	{ErrUniqueViolation, regexp.MustCompile(`(?:UNIQUE|PRIMARY KEY) constraint failed: (.+)$`), 0, 0, 1},
+	{ErrNotNullViolation, regexp.MustCompile(`NOT NULL constraint failed: (\S+)`), 0, 0, 1},
+	{ErrForeignKeyViolation, regexp.MustCompile(`FOREIGN KEY constraint failed`), 0, 0, 0},
+	{ErrCheckViolation, regexp.MustCompile(`CHECK constraint failed: (\S+)`), 0, 1, 0},
+}
+
+var mysqlConstraints = []constraintRule{
+	{ErrUniqueViolation, regexp.MustCompile(`Duplicate entry '.*' for key '([^']+)'`), 0, 1, 0},
+	{ErrNotNullViolation, regexp.MustCompile(`Column '([^']+)' cannot be null`), 0, 0, 1},
+	{ErrNotNullViolation, regexp.MustCompile(`Field '([^']+)' doesn't have a default value`), 0, 0, 1},
+	{ErrForeignKeyViolation, regexp.MustCompile("foreign key constraint fails \\((.+?), CONSTRAINT `([^`]+)` FOREIGN KEY \\(([^)]+)\\)"), 1, 2, 3},
+	{ErrCheckViolation, regexp.MustCompile(`Check constraint '([^']+)' is violated`), 0, 1, 0},
+}
+
+var postgresConstraints = []constraintRule{
+	{ErrUniqueViolation, regexp.MustCompile(`duplicate key value violates unique constraint "([^"]+)"`), 0, 1, 0},
+	{ErrNotNullViolation, regexp.MustCompile(`null value in column "([^"]+)" of relation "([^"]+)" violates not-null constraint`), 2, 0, 1},
+	{ErrNotNullViolation, regexp.MustCompile(`null value in column "([^"]+)" violates not-null constraint`), 0, 0, 1},
+	{ErrForeignKeyViolation, regexp.MustCompile(`on table "([^"]+)" violates foreign key constraint "([^"]+)"`), 1, 2, 0},
+	{ErrCheckViolation, regexp.MustCompile(`new row for relation "([^"]+)" violates check constraint "([^"]+)"`), 1, 2, 0},
+}
+
+var sqlserverConstraints = []constraintRule{
+	{ErrUniqueViolation, regexp.MustCompile(`Violation of (?:UNIQUE KEY|PRIMARY KEY) constraint '([^']+)'. Cannot insert duplicate key in object '([^']+)'`), 2, 1, 0},
+	{ErrUniqueViolation, regexp.MustCompile(`Cannot insert duplicate key row in object '([^']+)' with unique index '([^']+)'`), 1, 2, 0},
+	{ErrNotNullViolation, regexp.MustCompile(`Cannot insert the value NULL into column '([^']+)', table '([^']+)'`), 2, 0, 1},
+	{ErrForeignKeyViolation, regexp.MustCompile(`conflicted with the (?:FOREIGN KEY|REFERENCE)(?: SAME TABLE)? constraint "([^"]+)"`), 0, 1, 0},
+	{ErrCheckViolation, regexp.MustCompile(`conflicted with the CHECK constraint "([^"]+)"`), 0, 1, 0},
+}
+
+func (d sqliteDialect) ParseConstraint(err error) *ConstraintError {
+	return parseConstraint(sqliteConstraints, err)
+}
+
+func (d mysqlDialect) ParseConstraint(err error) *ConstraintError {
+	return parseConstraint(mysqlConstraints, err)
+}
+
+func (d postgresDialect) ParseConstraint(err error) *ConstraintError {
+	return parseConstraint(postgresConstraints, err)
+}
+
+func (d sqlserverDialect) ParseConstraint(err error) *ConstraintError {
+	return parseConstraint(sqlserverConstraints, err)
+}
+
+// constraintError translates the driver error into ConstraintError if
+// it is a constraint violation, filling in the table and columns
+func (t *Table) constraintError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var ce *ConstraintError
+	if errors.As(err, &ce) {
+		return err
+	}
+	parser, ok := t.GetDialect().(ConstraintParser)
+	if !ok {
+		return err
+	}
+	if ce = parser.ParseConstraint(err); ce == nil {
+		return err
+	}
+	if ce.Table == "" {
+		ce.Table = t.TableName
+	}
+	if len(ce.Columns) == 0 {
+		ce.Columns = detailColumns(err)
+	}
+	if len(ce.Columns) == 0 && ce.Table == t.TableName {
+		ce.Columns = t.constraintColumns(ce)
+	}
+	return ce
+}
+
+// Detailer is implemented by a driver error carrying the detail message
+// of the database, such as "Key (x, y)=(a, b) already exists." in Postgres.
+// The Detail field of the errors of pq and pgx is read likewise.
+type Detailer interface {
+	Detail() string
+}
+
+var reDetailKey = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+
+// detailColumns returns the columns named in the detail of err
+func detailColumns(err error) []string {
+	match := reDetailKey.FindStringSubmatch(errorDetail(err))
+	if match == nil {
+		return nil
+	}
+	var columns []string
+	for _, name := range strings.Split(match[1], ",") {
+		columns = append(columns, unquoteName(strings.TrimSpace(name)))
+	}
+	return columns
+}
+
+// errorDetail returns the detail in the chain of err, from Detailer or
+// from a string field named Detail
+func errorDetail(err error) string {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if x, ok := err.(Detailer); ok {
+			return x.Detail()
+		}
+		v := reflect.ValueOf(err)
+		if v.Kind() == reflect.Pointer {
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+		if field := v.FieldByName("Detail"); field.IsValid() && field.Kind() == reflect.String {
+			return field.String()
+		}
+	}
+	return ""
+}
+
+// constraintColumns maps the name of the violated constraint to the primary
+// key, Uniques or one of Fks of the table. If the name tells none of them,
+// the only key of the violated kind is taken.
+func (t *Table) constraintColumns(ce *ConstraintError) []string {
+	name := "_" + reNotWord.ReplaceAllString(strings.ToLower(ce.Constraint), "_") + "_"
+	names := func(columns []string) bool {
+		for _, column := range columns {
+			column = reNotWord.ReplaceAllString(strings.ToLower(column), "_")
+			if !strings.Contains(name, "_"+column+"_") {
+				return false
+			}
+		}
+		return len(columns) > 0
+	}
+
+	switch ce.Kind {
+	case ErrUniqueViolation:
+		if strings.Contains(name, "pkey") || strings.Contains(name, "_primary_") || strings.HasPrefix(name, "_pk_") {
+			return t.Pks
+		}
+		if names(t.Uniques) {
+			return t.Uniques
+		}
+		if names(t.Pks) {
+			return t.Pks
+		}
+		if len(t.Uniques) > 0 {
+			return t.Uniques
+		}
+		return t.Pks
+	case ErrForeignKeyViolation:
+		for _, fk := range t.Fks {
+			if columns := fk.GetColumns(); names(columns) {
+				return columns
+			}
+		}
+		if len(t.Fks) == 1 {
+			return t.Fks[0].GetColumns()
+		}
+	default:
+	}
+	return nil
+}
+
+var reNotWord = regexp.MustCompile(`[^a-z0-9]+`)
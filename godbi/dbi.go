@@ -15,6 +15,104 @@ type DBI struct {
 	*sql.DB
 	// Slogger: a logger for SQL execution
 	logger Slogger
+	// cache: the optional prepared statement cache
+	cache *StmtCache
+	// table: the table whose constraint violations are translated
+	table *Table
+}
+
+type txKey struct{}
+
+// ContextWithTx returns a copy of ctx carrying the transaction, in which
+// the statements of DBI, and so those of actions and molecules, are run.
+func ContextWithTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext returns the transaction carried by ctx, or nil
+func TxFromContext(ctx context.Context) *sql.Tx {
+	tx, _ := ctx.Value(txKey{}).(*sql.Tx)
+	return tx
+}
+
+// prepared returns the cached statement of query, bound to the transaction
+// in ctx if any, and the function to release it. The statement is nil if
+// there is no cache.
+func (d *DBI) prepared(ctx context.Context, query string) (*sql.Stmt, func(), error) {
+	if d.cache == nil {
+		return nil, nil, nil
+	}
+	return d.cache.StmtContext(ctx, d.DB, TxFromContext(ctx), query)
+}
+
+func (d *DBI) execContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	stmt, release, err := d.prepared(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if stmt != nil {
+		defer release()
+		return stmt.ExecContext(ctx, args...)
+	}
+	if tx := TxFromContext(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return d.DB.ExecContext(ctx, query, args...)
+}
+
+// queryContext returns the rows of query, and the function to call
+// after the rows are closed
+func (d *DBI) queryContext(ctx context.Context, query string, args ...any) (*sql.Rows, func(), error) {
+	stmt, release, err := d.prepared(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if stmt != nil {
+		rows, err := stmt.QueryContext(ctx, args...)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+		return rows, release, nil
+	}
+	var rows *sql.Rows
+	if tx := TxFromContext(ctx); tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = d.DB.QueryContext(ctx, query, args...)
+	}
+	return rows, func() {}, err
+}
+
+// constraintError translates the constraint violation by the table
+func (d *DBI) constraintError(err error) error {
+	if d.table == nil {
+		return err
+	}
+	return d.table.constraintError(err)
+}
+
+// queryRowScanContext queries a single row and scans it into dest
+func (d *DBI) queryRowScanContext(ctx context.Context, query string, args []any, dest ...any) error {
+	if d.logger != nil {
+		d.logger.Debug("godbi.DBI", "SQL", query, "ARGS", args)
+	}
+	rows, release, err := d.queryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer release()
+	defer rows.Close()
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return err
+	}
+	return rows.Close()
 }
 
 // TxSQL is the same as DoSQL, but use transaction
@@ -22,13 +120,18 @@ func (d *DBI) TxSQL(query string, args ...any) (sql.Result, error) {
 	return d.TxSQLContext(context.Background(), query, args...)
 }
 
-// TxSQLContext is the same as DoSQLContext, but use transaction
+// TxSQLContext is the same as DoSQLContext, but use transaction.
+// If ctx already carries a transaction, it runs in that one, leaving
+// commit and rollback to the owner of the transaction.
 func (d *DBI) TxSQLContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
-	tx, err := d.DB.Begin()
+	if TxFromContext(ctx) != nil {
+		return d.DoSQLContext(ctx, query, args...)
+	}
+
+	tx, err := d.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
-	//defer tx.Rollback()
 
 	if d.logger != nil {
 		d.logger.Debug("godbi.DBI", "SQL", query, "ARGS", args)
@@ -38,7 +141,7 @@ func (d *DBI) TxSQLContext(ctx context.Context, query string, args ...any) (sql.
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			return nil, errorRollback(err, rollbackErr)
 		} else {
-			return nil, err
+			return nil, d.constraintError(err)
 		}
 	}
 
@@ -57,20 +160,10 @@ func (d *DBI) InsertSerial(query string, args ...any) (int64, error) {
 
 // InsertSerialContext insert a SQL into Postgres table with Serial, only save the last inserted ID
 func (d *DBI) InsertSerialContext(ctx context.Context, query string, args ...any) (int64, error) {
-	stmt, err := d.DB.PrepareContext(ctx, query)
-	if err != nil {
-		return 0, err
-	}
-	defer stmt.Close()
 	var lastID int64
-	if d.logger != nil {
-		d.logger.Debug("godbi.DBI", "SQL", query, "ARGS", args)
-	}
-	err = stmt.QueryRowContext(ctx, args...).Scan(&lastID)
-	if err != nil {
-		return 0, err
+	if err := d.queryRowScanContext(ctx, query, args, &lastID); err != nil {
+		return 0, d.constraintError(err)
 	}
-
 	return lastID, nil
 }
 
@@ -84,9 +177,9 @@ func (d *DBI) InsertIDContext(ctx context.Context, query string, args ...any) (i
 	if d.logger != nil {
 		d.logger.Debug("godbi.DBI", "SQL", query, "ARGS", args)
 	}
-	res, err := d.DB.ExecContext(ctx, query, args...)
+	res, err := d.execContext(ctx, query, args...)
 	if err != nil {
-		return 0, err
+		return 0, d.constraintError(err)
 	}
 
 	lastID, err := res.LastInsertId()
@@ -107,9 +200,9 @@ func (d *DBI) DoSQLContext(ctx context.Context, query string, args ...any) (sql.
 	if d.logger != nil {
 		d.logger.Debug("godbi.DBI", "SQL", query, "ARGS", args)
 	}
-	res, err := d.DB.ExecContext(ctx, query, args...)
+	res, err := d.execContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, d.constraintError(err)
 	}
 
 	return res, nil
@@ -131,20 +224,34 @@ func (d *DBI) DoSQLsContext(ctx context.Context, query string, args ...[]any) (s
 		return d.DoSQLContext(ctx, query, args[0]...)
 	}
 
-	sth, err := d.DB.PrepareContext(ctx, query)
+	sth, release, err := d.prepared(ctx, query)
 	if err != nil {
 		return nil, err
 	}
+	if sth == nil {
+		if tx := TxFromContext(ctx); tx != nil {
+			sth, err = tx.PrepareContext(ctx, query)
+		} else {
+			sth, err = d.DB.PrepareContext(ctx, query)
+		}
+		if err != nil {
+			return nil, err
+		}
+		release = func() { sth.Close() }
+	}
+	defer release()
 
+	if d.logger != nil {
+		d.logger.Debug("godbi.DBI", "SQL", query, "ARGS", args)
+	}
 	var res sql.Result
 	for _, once := range args {
 		res, err = sth.ExecContext(ctx, once...)
 		if err != nil {
-			return nil, err
+			return nil, d.constraintError(err)
 		}
 	}
 
-	sth.Close()
 	return res, nil
 }
 
@@ -221,12 +328,13 @@ func (d *DBI) SelectSQLContext(ctx context.Context, lists *[]any, query string,
 	if d.logger != nil {
 		d.logger.Debug("godbi.DBI", "SQL", query, "ARGS", args)
 	}
-	rows, err := d.DB.QueryContext(ctx, query, args...)
+	rows, release, err := d.queryContext(ctx, query, args...)
 	if err != nil {
-		return err
+		return d.constraintError(err)
 	}
+	defer release()
 
-	return d.pickup(rows, lists, labels, query)
+	return d.constraintError(d.pickup(rows, lists, labels, query))
 }
 
 func (d *DBI) pickup(rows *sql.Rows, lists *[]any, labels []any, query string) error {
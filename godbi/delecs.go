@@ -3,7 +3,6 @@ package godbi
 import (
 	"context"
 	"database/sql"
-	"strings"
 )
 
 // Delecs is a special Topics action that returns all foreign keys
@@ -21,7 +20,7 @@ func (d *Delecs) RunAction(db *sql.DB, t *Table, args map[string]any, extra ...m
 // so that Fks could be passed to other Prepared delete actions.
 // If there is no Fks, we putput the input, then Delecs does nothing.
 func (d *Delecs) RunActionContext(ctx context.Context, db *sql.DB, t *Table, args map[string]any, extra ...map[string]any) ([]any, error) {
-	dbi := &DBI{DB: db, logger: t.logger}
+	dbi := t.dbi(db)
 	lists := make([]any, 0)
 	if t.Fks == nil {
 		return []any{args}, nil
@@ -31,30 +30,29 @@ func (d *Delecs) RunActionContext(ctx context.Context, db *sql.DB, t *Table, arg
 	for _, pk := range t.Pks {
 		if v, ok := args[pk]; ok {
 			if str != "" {
-				str += ", "
+				str += " AND "
 			}
-			str += pk + "=?"
+			str += t.quote(pk) + "=?"
 			values = append(values, v)
 		}
 	}
 	for _, fk := range t.Fks {
-		name := fk.Column
-		if v, ok := args[name]; ok {
-			if str != "" {
-				str += ", "
+		for _, name := range fk.GetColumns() {
+			if v, ok := args[name]; ok {
+				if str != "" {
+					str += " AND "
+				}
+				str += t.quote(name) + "=?"
+				values = append(values, v)
 			}
-			str += name + "=?"
-			values = append(values, v)
 		}
 	}
 	if !hasValue(values) {
 		return nil, errorMissingKeys(t.TableName)
 	}
-	if t.dbDriver == Postgres {
-		str = questionMarkerNumber(str)
-	}
 	// should we add labels as well, if it != column name ?
-	err := dbi.SelectContext(ctx, &lists, `SELECT `+strings.Join(t.getKeyColumns(), ", ")+` FROM `+t.TableName+` WHERE `+str, values...)
+	str = `SELECT ` + t.quoteList(t.getKeyColumns()) + ` FROM ` + t.quotedName() + ` WHERE ` + str
+	err := dbi.SelectContext(ctx, &lists, t.GetDialect().Placeholder(str), values...)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,11 @@ import (
 // Delete struct for row deletion by primary key
 type Delete struct {
 	Action
+	// ROWSAFFECTED: if set, the number of rows deleted is reported
+	// in the output under this key
+	ROWSAFFECTED string `json:"rowsaffected,omitempty" hcl:"rowsaffected,optional"`
+	// Strict: if true, return ErrNotFound when no row is deleted
+	Strict bool `json:"strict,omitempty" hcl:"strict,optional"`
 }
 
 var _ Capability = (*Delete)(nil)
@@ -21,18 +26,40 @@ func (d *Delete) RunActionContext(ctx context.Context, db *sql.DB, t *Table, arg
 	if !hasValue(ids) {
 		return nil, errorMissingPk(t.TableName)
 	}
+	ids, err := t.coerceIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	extra, err = t.coerceExtra(extra...)
+	if err != nil {
+		return nil, err
+	}
 
-	sql := "DELETE FROM " + t.TableName
+	sql := "DELETE FROM " + t.quotedName()
 	where, values := t.singleCondition(ids, "", extra...)
 	if where != "" {
 		sql += "\nWHERE " + where
 	} else {
 		return nil, errorDeleteWhole(t.TableName)
 	}
-	dbi := &DBI{DB: db, logger: t.logger}
-	if t.dbDriver == Postgres {
-		sql = questionMarkerNumber(sql)
+	dbi := t.dbi(db)
+	res, err := dbi.DoSQLContext(ctx, t.GetDialect().Placeholder(sql), values...)
+	if err != nil {
+		return nil, err
+	}
+	if !d.Strict && d.ROWSAFFECTED == "" {
+		return nil, nil
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if d.Strict && affected == 0 {
+		return nil, errorNotFound(t.TableName)
+	}
+	if d.ROWSAFFECTED != "" {
+		return fromFv(map[string]any{d.ROWSAFFECTED: affected}), nil
 	}
-	_, err := dbi.DoSQLContext(ctx, sql, values...)
-	return nil, err
+	return nil, nil
 }
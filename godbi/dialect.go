@@ -0,0 +1,315 @@
+package godbi
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Dialect describes how SQL statements are written for a database.
+// The built-in dialects are registered as "sqlite3", "mysql", "postgres"
+// and "sqlserver".
+// Other databases are added by RegisterDialect.
+type Dialect interface {
+	// Name returns the name of the dialect
+	Name() string
+	// Placeholder rewrites question marks in query into native placeholders
+	Placeholder(query string) string
+	// Quote quotes identifier name. A dotted name is quoted in parts
+	Quote(name string) string
+	// Returning tells if INSERT and UPDATE accept a RETURNING clause
+	Returning() bool
+	// InsertID rewrites the INSERT query to return the quoted auto id column.
+	// An empty string means the id is read from LastInsertId.
+	InsertID(query, idAuto string) string
+	// DefaultValues returns the INSERT clause for a row of default values
+	DefaultValues() string
+	// Upsert returns the clause appended to INSERT to update fields when
+	// the row conflicts on uniques. An empty string means not supported.
+	Upsert(uniques, fields []string) string
+	// Limit returns the clause to paginate a select
+	Limit(limit, offset int) string
+	// Bool encodes the boolean value
+	Bool(b bool) any
+	// Savepoint returns the statement to create savepoint name
+	Savepoint(name string) string
+	// ReleaseSavepoint returns the statement to release savepoint name.
+	// An empty string means no release is needed.
+	ReleaseSavepoint(name string) string
+	// RollbackSavepoint returns the statement to roll back to savepoint name
+	RollbackSavepoint(name string) string
+}
+
+// Merger is implemented by a dialect writing a single statement to
+// insert or update a row by its unique key, which Insupd then uses.
+type Merger interface {
+	// Merge returns the statement merging fields into table on uniques.
+	// Values are bound in the order of fields, which include uniques.
+	Merge(table string, uniques, fields []string) string
+}
+
+// Recurser is implemented by a dialect whose recursive common table
+// expression differs from WITH RECURSIVE ... UNION.
+type Recurser interface {
+	// Recursive returns the keyword opening a recursive common table
+	// expression, and the operator joining its anchor and recursive parts
+	Recursive() (with, union string)
+}
+
+var (
+	dialectMu sync.RWMutex
+	dialects  = make(map[string]Dialect)
+)
+
+// RegisterDialect registers dialect by name, replacing any existing one
+func RegisterDialect(name string, dialect Dialect) {
+	dialectMu.Lock()
+	defer dialectMu.Unlock()
+	dialects[name] = dialect
+}
+
+// GetDialect returns the registered dialect by name, or nil if not found.
+// The names accepted by DBTypeByName return the built-in dialects.
+func GetDialect(name string) Dialect {
+	dialectMu.RLock()
+	dialect, ok := dialects[name]
+	dialectMu.RUnlock()
+	if ok {
+		return dialect
+	}
+	if driver := DBTypeByName(name); driver != SQLDefault {
+		return driver.Dialect()
+	}
+	return nil
+}
+
+func init() {
+	for _, driver := range []DBType{SQLite, MySQL, Postgres, SQLServer} {
+		RegisterDialect(driver.LowerName(), driver.Dialect())
+	}
+}
+
+// Dialect returns the built-in dialect of the DBType
+func (d DBType) Dialect() Dialect {
+	switch d {
+	case SQLite:
+		return sqliteDialect{}
+	case MySQL:
+		return mysqlDialect{}
+	case Postgres:
+		return postgresDialect{}
+	case SQLServer:
+		return sqlserverDialect{}
+	default:
+	}
+	return defaultDialect{}
+}
+
+// quoteWith quotes name by quote character q
+func quoteWith(q, name string) string {
+	if name == "" || name == "*" || strings.HasPrefix(name, q) {
+		return name
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if part == "*" {
+			continue
+		}
+		parts[i] = q + strings.ReplaceAll(part, q, q+q) + q
+	}
+	return strings.Join(parts, ".")
+}
+
+// defaultDialect writes generic SQL without quoting identifiers.
+// It is the base of the built-in dialects.
+type defaultDialect struct{}
+
+func (d defaultDialect) Name() string                    { return "" }
+func (d defaultDialect) Placeholder(query string) string { return query }
+func (d defaultDialect) Quote(name string) string        { return name }
+func (d defaultDialect) Returning() bool                 { return false }
+func (d defaultDialect) DefaultValues() string           { return "DEFAULT VALUES" }
+
+func (d defaultDialect) InsertID(query, idAuto string) string {
+	return ""
+}
+
+func (d defaultDialect) Upsert(uniques, fields []string) string {
+	return ""
+}
+
+func (d defaultDialect) Limit(limit, offset int) string {
+	return "LIMIT " + strconv.Itoa(limit) + " OFFSET " + strconv.Itoa(offset)
+}
+
+func (d defaultDialect) Bool(b bool) any {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+func (d defaultDialect) Savepoint(name string) string {
+	return "SAVEPOINT " + name
+}
+
+func (d defaultDialect) ReleaseSavepoint(name string) string {
+	return "RELEASE SAVEPOINT " + name
+}
+
+func (d defaultDialect) RollbackSavepoint(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + name
+}
+
+// onConflict returns the upsert clause shared by SQLite and Postgres
+func onConflict(dialect Dialect, uniques, fields []string) string {
+	if len(uniques) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(uniques))
+	for i, name := range uniques {
+		quoted[i] = dialect.Quote(name)
+	}
+	str := "ON CONFLICT (" + strings.Join(quoted, ", ") + ") DO "
+	if len(fields) == 0 {
+		return str + "NOTHING"
+	}
+	sets := make([]string, len(fields))
+	for i, name := range fields {
+		name = dialect.Quote(name)
+		sets[i] = name + "=EXCLUDED." + name
+	}
+	return str + "UPDATE SET " + strings.Join(sets, ", ")
+}
+
+type sqliteDialect struct{ defaultDialect }
+
+func (d sqliteDialect) Name() string             { return "sqlite3" }
+func (d sqliteDialect) Quote(name string) string { return quoteWith(`"`, name) }
+func (d sqliteDialect) Returning() bool          { return true }
+func (d sqliteDialect) Upsert(uniques, fields []string) string {
+	return onConflict(d, uniques, fields)
+}
+
+func (d sqliteDialect) Bool(b bool) any {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type mysqlDialect struct{ defaultDialect }
+
+func (d mysqlDialect) Name() string             { return "mysql" }
+func (d mysqlDialect) Quote(name string) string { return quoteWith("`", name) }
+func (d mysqlDialect) DefaultValues() string    { return "() VALUES ()" }
+
+// Upsert updates fields on a conflict of any unique key of the table.
+// Without fields, the first of uniques is set to itself.
+func (d mysqlDialect) Upsert(uniques, fields []string) string {
+	if len(uniques) == 0 {
+		return ""
+	}
+	if len(fields) == 0 {
+		name := d.Quote(uniques[0])
+		return "ON DUPLICATE KEY UPDATE " + name + "=" + name
+	}
+	sets := make([]string, len(fields))
+	for i, name := range fields {
+		name = d.Quote(name)
+		sets[i] = name + "=VALUES(" + name + ")"
+	}
+	return "ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+}
+
+type postgresDialect struct{ defaultDialect }
+
+func (d postgresDialect) Name() string                    { return "postgres" }
+func (d postgresDialect) Placeholder(query string) string { return questionMarkerNumber(query) }
+func (d postgresDialect) Quote(name string) string        { return quoteWith(`"`, name) }
+func (d postgresDialect) Returning() bool                 { return true }
+func (d postgresDialect) InsertID(query, idAuto string) string {
+	return query + " RETURNING " + idAuto
+}
+
+func (d postgresDialect) Upsert(uniques, fields []string) string {
+	return onConflict(d, uniques, fields)
+}
+
+type sqlserverDialect struct{ defaultDialect }
+
+func (d sqlserverDialect) Name() string { return "sqlserver" }
+
+func (d sqlserverDialect) Placeholder(query string) string {
+	return markerNumber(reQuestionBracket, query, "@p")
+}
+
+func (d sqlserverDialect) Quote(name string) string {
+	if name == "" || name == "*" || strings.HasPrefix(name, "[") {
+		return name
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if part == "*" {
+			continue
+		}
+		parts[i] = "[" + strings.ReplaceAll(part, "]", "]]") + "]"
+	}
+	return strings.Join(parts, ".")
+}
+
+// InsertID puts OUTPUT INSERTED before VALUES
+func (d sqlserverDialect) InsertID(query, idAuto string) string {
+	output := " OUTPUT INSERTED." + idAuto
+	for _, clause := range []string{" VALUES (", " DEFAULT VALUES"} {
+		if i := strings.Index(query, clause); i >= 0 {
+			return query[:i] + output + query[i:]
+		}
+	}
+	return ""
+}
+
+func (d sqlserverDialect) Limit(limit, offset int) string {
+	return "OFFSET " + strconv.Itoa(offset) + " ROWS FETCH NEXT " + strconv.Itoa(limit) + " ROWS ONLY"
+}
+
+func (d sqlserverDialect) Bool(b bool) any {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (d sqlserverDialect) Savepoint(name string) string {
+	return "SAVE TRANSACTION " + name
+}
+
+func (d sqlserverDialect) ReleaseSavepoint(name string) string {
+	return ""
+}
+
+func (d sqlserverDialect) RollbackSavepoint(name string) string {
+	return "ROLLBACK TRANSACTION " + name
+}
+
+// Recursive uses UNION ALL, the only operator SQL Server allows
+func (d sqlserverDialect) Recursive() (string, string) { return "WITH", "UNION ALL" }
+
+func (d sqlserverDialect) Merge(table string, uniques, fields []string) string {
+	var cols, sources, ons, sets []string
+	for _, name := range fields {
+		quoted := d.Quote(name)
+		cols = append(cols, quoted)
+		sources = append(sources, "source."+quoted)
+		if grep(uniques, name) {
+			ons = append(ons, "target."+quoted+"=source."+quoted)
+		} else {
+			sets = append(sets, "target."+quoted+"=source."+quoted)
+		}
+	}
+	str := "MERGE INTO " + table + " WITH (HOLDLOCK) AS target\nUSING (VALUES (" + strings.Join(strings.Split(strings.Repeat("?", len(fields)), ""), ",") + ")) AS source (" + strings.Join(cols, ", ") + ")\nON " + strings.Join(ons, " AND ")
+	if sets != nil {
+		str += "\nWHEN MATCHED THEN UPDATE SET " + strings.Join(sets, ", ")
+	}
+	return str + "\nWHEN NOT MATCHED THEN INSERT (" + strings.Join(cols, ", ") + ") VALUES (" + strings.Join(sources, ", ") + ");"
+}
@@ -0,0 +1,83 @@
+package godbi
+
+import (
+	"testing"
+)
+
+type upperDialect struct{ sqliteDialect }
+
+func (d upperDialect) Name() string { return "upper" }
+
+func (d upperDialect) Bool(b bool) any {
+	if b {
+		return "T"
+	}
+	return "F"
+}
+
+func TestDialect(t *testing.T) {
+	for _, name := range []string{"sqlite3", "SQLite", "mysql", "postgres", "PostgreSQL"} {
+		if GetDialect(name) == nil {
+			t.Errorf("%s not found", name)
+		}
+	}
+	if GetDialect("nodb") != nil {
+		t.Errorf("nodb found")
+	}
+
+	pg := Postgres.Dialect()
+	if x := pg.Placeholder(`SELECT "?" FROM a WHERE x=? AND y=?`); x != `SELECT "?" FROM a WHERE x=$1 AND y=$2` {
+		t.Errorf("%s", x)
+	}
+	if x := pg.Upsert([]string{"x"}, []string{"y", "z"}); x != `ON CONFLICT ("x") DO UPDATE SET "y"=EXCLUDED."y", "z"=EXCLUDED."z"` {
+		t.Errorf("%s", x)
+	}
+	if x := MySQL.Dialect().Upsert([]string{"x"}, []string{"y"}); x != "ON DUPLICATE KEY UPDATE `y`=VALUES(`y`)" {
+		t.Errorf("%s", x)
+	}
+	if x := MySQL.Dialect().Upsert([]string{"x"}, nil); x != "ON DUPLICATE KEY UPDATE `x`=`x`" {
+		t.Errorf("%s", x)
+	}
+	if x := SQLite.Dialect().RollbackSavepoint("s1"); x != "ROLLBACK TO SAVEPOINT s1" {
+		t.Errorf("%s", x)
+	}
+	if x := SQLServer.Dialect().ReleaseSavepoint("s1"); x != "" {
+		t.Errorf("%s", x)
+	}
+
+	table := &Table{TableName: "m_a", Uniques: []string{"x"}}
+	table.SetDBDriver(Postgres)
+	if x, values := table.upsertSQL(map[string]any{"x": "a", "y": 1}); x != `INSERT INTO "m_a" ("x", "y") VALUES ($1,$2) ON CONFLICT ("x") DO UPDATE SET "y"=EXCLUDED."y"` || len(values) != 2 {
+		t.Errorf("%s %v", x, values)
+	}
+	table.SetDBDriver(SQLRaw)
+	if x, _ := table.upsertSQL(map[string]any{"x": "a"}); x != "" {
+		t.Errorf("%s", x)
+	}
+	if x := pg.InsertID(`INSERT INTO "a" DEFAULT VALUES`, `"id"`); x != `INSERT INTO "a" DEFAULT VALUES RETURNING "id"` {
+		t.Errorf("%s", x)
+	}
+	if x := MySQL.Dialect().DefaultValues(); x != "() VALUES ()" {
+		t.Errorf("%s", x)
+	}
+	if x := pg.DefaultValues(); x != "DEFAULT VALUES" {
+		t.Errorf("%s", x)
+	}
+	if x := SQLite.Dialect().Limit(10, 20); x != "LIMIT 10 OFFSET 20" {
+		t.Errorf("%s", x)
+	}
+
+	RegisterDialect("upper", upperDialect{})
+	molecule := &Molecule{
+		Atoms:   []*Atom{{AtomName: "m_a", Table: Table{TableName: "m_a", Columns: []*Col{{ColumnName: "y", Label: "y", TypeName: "bool"}}}}},
+		Dialect: "upper",
+	}
+	atom := molecule.GetAtom("m_a")
+	if atom.GetDialect().Name() != "upper" {
+		t.Errorf("%#v", atom.GetDialect())
+	}
+	fv, _, err := atom.getFv(map[string]any{"y": true}, nil)
+	if err != nil || fv["y"] != "T" {
+		t.Errorf("%v %#v", err, fv)
+	}
+}
@@ -9,6 +9,8 @@ import (
 type Edit struct {
 	Action
 	FIELDS string `json:"fields,omitempty" hcl:"fields,optional"`
+	// Strict: if true, return ErrNotFound when no row is found
+	Strict bool `json:"strict,omitempty" hcl:"strict,optional"`
 }
 
 var _ Capability = (*Edit)(nil)
@@ -26,22 +28,30 @@ func (e *Edit) RunAction(db *sql.DB, t *Table, args map[string]any, extra ...map
 
 func (e *Edit) RunActionContext(ctx context.Context, db *sql.DB, t *Table, args map[string]any, extra ...map[string]any) ([]any, error) {
 	e.setDefaultElementNames()
-	sql, labels := t.filterPars(args, e.FIELDS, e.getAllowed())
+	sql, labels := e.selectPars(t, args, e.FIELDS)
 
 	ids := t.getIDVal(args, extra...)
 	if !hasValue(ids) {
 		return nil, errorMissingPk(t.TableName)
 	}
+	ids, err := t.coerceIDs(ids)
+	if err != nil {
+		return nil, err
+	}
 
-	newExtra := t.byConstraint(args, extra...)
+	newExtra, err := t.coerceExtra(t.byConstraint(args, extra...))
+	if err != nil {
+		return nil, err
+	}
 
-	where, extraValues := t.singleCondition(ids, t.TableName, newExtra)
+	where, extraValues := t.singleCondition(ids, t.QualifiedName(), newExtra...)
 	if where != "" {
 		sql += "\nWHERE " + where
 	}
-	if t.dbDriver == Postgres {
-		sql = questionMarkerNumber(sql)
-	}
 
-	return getSQL(ctx, db, t.logger, sql, labels, extraValues...)
+	lists, err := getSQL(ctx, t.dbi(db), t.GetDialect().Placeholder(sql), labels, extraValues...)
+	if err == nil && e.Strict && len(lists) == 0 {
+		return nil, errorNotFound(t.TableName)
+	}
+	return lists, err
 }
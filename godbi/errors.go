@@ -1,61 +1,201 @@
 package godbi
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
+// The kinds of errors found by godbi, to be tested by errors.Is
+var (
+	// ErrNotFound is returned by Update, Delete and Edit in strict mode
+	// when no row matches the primary key.
+	ErrNotFound = errors.New("record not found")
+	// ErrAtomNotFound: the atom is not in the molecule
+	ErrAtomNotFound = errors.New("atom not found")
+	// ErrActionNotFound: the action is not in the atom
+	ErrActionNotFound = errors.New("action not found")
+	// ErrDuplicate: an atom or action is defined more than once
+	ErrDuplicate = errors.New("duplicate definition")
+	// ErrMissingKey: the table has no primary, foreign or unique key needed
+	ErrMissingKey = errors.New("missing key")
+	// ErrInvalidInput: the input data are missing or of wrong type
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrNotUnique: more than one row found by the unique key
+	ErrNotUnique = errors.New("not unique")
+	// ErrDeleteWhole: delete without constraint
+	ErrDeleteWhole = errors.New("delete whole table not allowed")
+	// ErrTimeout: a molecule, atom, action or connection exceeded its timeout
+	ErrTimeout = errors.New("timeout exceeded")
+	// ErrLimit: a molecule run exceeded its Limits
+	ErrLimit = errors.New("limit exceeded")
+	// ErrCycle: connections lead back to the same action on the same input
+	ErrCycle = errors.New("cycle detected")
+)
+
+// Error is an error found by godbi. Its Kind is one of the Err variables,
+// so errors.Is(err, ErrMissingKey) tells if err is of the kind.
+type Error struct {
+	Kind error
+	// Name is the atom, action, table or column concerned
+	Name string
+	// Err is the cause, if any
+	Err error
+	msg string
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// Is reports if target is the kind of the error
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+// Unwrap returns the cause
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func newError(kind error, name string, err error, format string, a ...any) error {
+	return &Error{Kind: kind, Name: name, Err: err, msg: fmt.Sprintf(format, a...)}
+}
+
+// PathError is the error of a molecule run, with the path of atoms and
+// actions, through prepares and nextpages, where it happened.
+// A step of the path is atom.action, suffixed by the index of the input
+// row if there are many, e.g. order.insert > item.insert[3].
+type PathError struct {
+	Path []string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	return strings.Join(e.Path, " > ") + ": " + e.Err.Error()
+}
+
+// Unwrap returns the original error, e.g. a driver error
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// withPath prefixes step to the path of err
+func withPath(err error, step string) error {
+	if err == nil {
+		return nil
+	}
+	if e, ok := err.(*PathError); ok {
+		return &PathError{Path: append([]string{step}, e.Path...), Err: e.Err}
+	}
+	return &PathError{Path: []string{step}, Err: err}
+}
+
 func errorActionNotDefined(name string) error {
-	return fmt.Errorf("action %s not defined", name)
+	return newError(ErrActionNotFound, name, nil, "action %s not defined", name)
 }
 
 func errorActionNil(name string) error {
-	return fmt.Errorf("actions or action %s is nil", name)
+	return newError(ErrActionNotFound, name, nil, "actions or action %s is nil", name)
 }
 
 func errorInputDataType(v any) error {
-	return fmt.Errorf("wrong input data type %T", v)
+	return newError(ErrInvalidInput, "", nil, "wrong input data type %T", v)
 }
 
 func errorExtraDataType(v any) error {
-	return fmt.Errorf("wrong extra data type %T", v)
+	return newError(ErrInvalidInput, "", nil, "wrong extra data type %T", v)
 }
 
 func errorAtomNotFound(name string) error {
-	return fmt.Errorf("atom %s not found in molecule", name)
+	return newError(ErrAtomNotFound, name, nil, "atom %s not found in molecule", name)
 }
 
 func errorActionNotFound(action, atom string) error {
-	return fmt.Errorf("action %s not found in atom %s", action, atom)
+	return newError(ErrActionNotFound, action, nil, "action %s not found in atom %s", action, atom)
+}
+
+func errorActionType(name, actionType string) error {
+	return newError(ErrActionNotFound, name, nil, "action %s of type %s not registered", name, actionType)
+}
+
+func errorConnectType(name string) error {
+	return newError(ErrInvalidInput, name, nil, "connect type %s not found", name)
+}
+
+func errorTimeoutValue(name, timeout string) error {
+	return newError(ErrInvalidInput, name, nil, "timeout %s of %s is not a duration", timeout, name)
+}
+
+func errorTimeout(name string, d time.Duration, err error) error {
+	return newError(ErrTimeout, name, err, "%s exceeded timeout %s: %v", name, d, err)
+}
+
+func errorLimit(name string, limit int) error {
+	return newError(ErrLimit, name, nil, "run exceeds the limit of %d %s", limit, name)
+}
+
+func errorCycle(step string) error {
+	return newError(ErrCycle, step, nil, "%s runs again on the same input", step)
+}
+
+func errorSelect(path, atom string) error {
+	return newError(ErrInvalidInput, path, nil, "selection %s is neither a field nor a nextpage of atom %s", path, atom)
+}
+
+func errorFilter(key, atom string) error {
+	return newError(ErrInvalidInput, key, nil, "filter %s is not a nextpage of atom %s related by extra", key, atom)
+}
+
+func errorDuplicateAtom(name string) error {
+	return newError(ErrDuplicate, name, nil, "atom %s defined more than once", name)
+}
+
+func errorDuplicateAction(action, atom string) error {
+	return newError(ErrDuplicate, action, nil, "action %s defined more than once in atom %s", action, atom)
 }
 
 func errorRollback(err, roolbackErr error) error {
-	return fmt.Errorf("error original: %v, rollback: %v", err, roolbackErr)
+	return fmt.Errorf("error original: %w, rollback: %w", err, roolbackErr)
 }
 
 func errorMissingKeys(name string) error {
-	return fmt.Errorf("no pk nor fk is found in table %s", name)
+	return newError(ErrMissingKey, name, nil, "no pk nor fk is found in table %s", name)
 }
 
 func errorMissingPk(name string) error {
-	return fmt.Errorf("no pk is found in table %s", name)
+	return newError(ErrMissingKey, name, nil, "no pk is found in table %s", name)
+}
+
+func errorMissingPkValue(pk, name string) error {
+	return newError(ErrMissingKey, pk, nil, "pk %s of table %s has no value to read the inserted row", pk, name)
 }
 
 func errorDeleteWhole(name string) error {
-	return fmt.Errorf("delete whole table %s not allowed", name)
+	return newError(ErrDeleteWhole, name, nil, "delete whole table %s not allowed", name)
 }
 
 func errorEmptyInput(name string) error {
-	return fmt.Errorf("no input data to %s", name)
+	return newError(ErrInvalidInput, name, nil, "no input data to %s", name)
 }
 
 func errorNoSuchColumn(name string) error {
-	return fmt.Errorf("column '%s' not found in input", name)
+	return newError(ErrInvalidInput, name, nil, "column '%s' not found in input", name)
 }
 
 func errorNoUniqueKey(name string) error {
-	return fmt.Errorf("unique key not defined in %s", name)
+	return newError(ErrMissingKey, name, nil, "unique key not defined in %s", name)
 }
 
 func errorNotUnique(name string) error {
-	return fmt.Errorf("multiple records found for unique key in %s", name)
+	return newError(ErrNotUnique, name, nil, "multiple records found for unique key in %s", name)
+}
+
+func errorCoerce(name, typeName string, v any, err error) error {
+	return newError(ErrInvalidInput, name, err, "column %s: cannot convert %v (%T) to %s: %v", name, v, v, typeName, err)
+}
+
+func errorNotFound(name string) error {
+	return newError(ErrNotFound, name, nil, "%v in table %s", ErrNotFound, name)
 }
@@ -0,0 +1,210 @@
+package godbi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+func TestErrorPath(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`,
+		`CREATE TABLE m_b (tid integer primary key autoincrement, id int, y varchar(8) not null)`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	molecule := &Molecule{
+		Atoms: []*Atom{{
+			AtomName: "m_a",
+			Table: Table{TableName: "m_a", Pks: []string{"id"}, IDAuto: "id", Columns: []*Col{
+				{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+				{ColumnName: "x", Label: "x", TypeName: "string", Notnull: true}}},
+			Actions: []Capability{&Insert{Action: Action{ActionName: "insert", IsDo: true, Nextpages: []*Connection{
+				{AtomName: "m_b", ActionName: "insert", RelateArgs: map[string]string{"id": "id"}, Marker: "m_b"}}}}},
+		}, {
+			AtomName: "m_b",
+			Table: Table{TableName: "m_b", Pks: []string{"tid"}, IDAuto: "tid", Columns: []*Col{
+				{ColumnName: "tid", Label: "tid", TypeName: "int", Auto: true},
+				{ColumnName: "id", Label: "id", TypeName: "int"},
+				{ColumnName: "y", Label: "y", TypeName: "string"}}},
+			Actions: []Capability{&Insert{Action: Action{ActionName: "insert", IsDo: true}}},
+		}},
+		DBDriver: SQLite,
+	}
+	ctx := context.Background()
+
+	_, err = molecule.RunContext(ctx, db, "m_c", "insert", &RunOption{Args: map[string]any{"x": "a"}})
+	var pathErr *PathError
+	if !errors.Is(err, ErrAtomNotFound) || !errors.As(err, &pathErr) || pathErr.Path[0] != "m_c.insert" {
+		t.Errorf("%v", err)
+	}
+
+	// the second row of m_b misses y, so fails in the driver
+	args := map[string]any{"x": "a", "m_b": []any{map[string]any{"y": "b"}, map[string]any{"tid": 9}}}
+	_, err = molecule.RunContext(ctx, db, "m_a", "insert", &RunOption{Args: args})
+	if err == nil {
+		t.Fatal("constraint not violated")
+	}
+	if !errors.As(err, &pathErr) || len(pathErr.Path) != 2 || pathErr.Path[1] != "m_b.insert[1]" {
+		t.Errorf("%v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "m_a.insert > m_b.insert[1]: ") {
+		t.Errorf("%v", err)
+	}
+	var driverErr sqlite3.Error
+	if !errors.As(err, &driverErr) || driverErr.Code != sqlite3.ErrConstraint {
+		t.Errorf("%#v", err)
+	}
+
+	// the second row has no column of m_a
+	_, err = molecule.RunContext(ctx, db, "m_a", "insert", &RunOption{Args: []any{map[string]any{"x": "a"}, map[string]any{"z": 1}}})
+	var godbiErr *Error
+	if !errors.Is(err, ErrInvalidInput) || !errors.As(err, &godbiErr) || godbiErr.Kind != ErrInvalidInput {
+		t.Errorf("%v", err)
+	}
+	if !errors.As(err, &pathErr) || len(pathErr.Path) != 1 || pathErr.Path[0] != "m_a.insert[1]" {
+		t.Errorf("%v", err)
+	}
+}
+
+func TestConstraintError(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`PRAGMA foreign_keys = ON`,
+		`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null, y varchar(8), UNIQUE (x, y))`,
+		`CREATE TABLE m_b (tid integer primary key autoincrement, id int REFERENCES m_a (id), z int CHECK (z > 0))`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	molecule := &Molecule{
+		Atoms: []*Atom{{
+			AtomName: "m_a",
+			Table: Table{TableName: "m_a", Pks: []string{"id"}, IDAuto: "id", Uniques: []string{"x", "y"}, Columns: []*Col{
+				{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+				{ColumnName: "x", Label: "x", TypeName: "string"},
+				{ColumnName: "y", Label: "y", TypeName: "string"}}},
+			Actions: []Capability{&Insert{Action: Action{ActionName: "insert", IsDo: true}}},
+		}, {
+			AtomName: "m_b",
+			Table: Table{TableName: "m_b", Pks: []string{"tid"}, IDAuto: "tid", Fks: []*Fk{{FkTable: "m_a", FkColumn: "id", Column: "id"}}, Columns: []*Col{
+				{ColumnName: "tid", Label: "tid", TypeName: "int", Auto: true},
+				{ColumnName: "id", Label: "id", TypeName: "int"},
+				{ColumnName: "z", Label: "z", TypeName: "int"}}},
+			Actions: []Capability{&Insert{Action: Action{ActionName: "insert", IsDo: true}}},
+		}},
+		DBDriver: SQLite,
+	}
+	ctx := context.Background()
+
+	if _, err = molecule.RunContext(ctx, db, "m_a", "insert", &RunOption{Args: map[string]any{"x": "a", "y": "b"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		atom    string
+		args    map[string]any
+		kind    error
+		columns string
+	}{
+		{"m_a", map[string]any{"x": "a", "y": "b"}, ErrUniqueViolation, "x,y"},
+		{"m_a", map[string]any{"y": "b"}, ErrNotNullViolation, "x"},
+		{"m_b", map[string]any{"id": 9, "z": 1}, ErrForeignKeyViolation, "id"},
+		{"m_b", map[string]any{"id": 1, "z": -1}, ErrCheckViolation, ""},
+	} {
+		_, err = molecule.RunContext(ctx, db, c.atom, "insert", &RunOption{Args: c.args})
+		var ce *ConstraintError
+		if !errors.Is(err, ErrConstraint) || !errors.Is(err, c.kind) || !errors.As(err, &ce) {
+			t.Fatalf("%v", err)
+		}
+		if ce.Table != c.atom || strings.Join(ce.Columns, ",") != c.columns {
+			t.Errorf("%#v", ce)
+		}
+		var driverErr sqlite3.Error
+		if !errors.As(err, &driverErr) || driverErr.Code != sqlite3.ErrConstraint {
+			t.Errorf("%#v", err)
+		}
+	}
+
+	table := &Table{TableName: "m_b", Pks: []string{"tid"}, Uniques: []string{"x", "y"}, Fks: []*Fk{{FkTable: "m_a", FkColumn: "id", Column: "id"}}}
+	for _, c := range []struct {
+		driver     DBType
+		msg        string
+		kind       error
+		table      string
+		constraint string
+		columns    string
+	}{
+		{MySQL, "Error 1062 (23000): Duplicate entry 'a-b' for key 'm_b.x_y'", ErrUniqueViolation, "m_b", "x_y", "x,y"},
+		{MySQL, "Error 1048 (23000): Column 'x' cannot be null", ErrNotNullViolation, "m_b", "", "x"},
+		{MySQL, "Error 1452 (23000): Cannot add or update a child row: a foreign key constraint fails (`db`.`m_b`, CONSTRAINT `m_b_ibfk_1` FOREIGN KEY (`id`) REFERENCES `m_a` (`id`))", ErrForeignKeyViolation, "m_b", "m_b_ibfk_1", "id"},
+		{Postgres, `pq: duplicate key value violates unique constraint "m_b_pkey"`, ErrUniqueViolation, "m_b", "m_b_pkey", "tid"},
+		{Postgres, `ERROR: null value in column "x" of relation "m_b" violates not-null constraint (SQLSTATE 23502)`, ErrNotNullViolation, "m_b", "", "x"},
+		{Postgres, `pq: insert or update on table "m_b" violates foreign key constraint "m_b_id_fkey"`, ErrForeignKeyViolation, "m_b", "m_b_id_fkey", "id"},
+		{Postgres, `pq: new row for relation "m_b" violates check constraint "m_b_z_check"`, ErrCheckViolation, "m_b", "m_b_z_check", ""},
+		{SQLServer, "mssql: Violation of UNIQUE KEY constraint 'UQ_m_b_x_y'. Cannot insert duplicate key in object 'dbo.m_b'. The duplicate key value is (a, b).", ErrUniqueViolation, "m_b", "UQ_m_b_x_y", "x,y"},
+		{SQLServer, "mssql: Cannot insert the value NULL into column 'x', table 'db.dbo.m_b'; column does not allow nulls. INSERT fails.", ErrNotNullViolation, "m_b", "", "x"},
+		{SQLServer, `mssql: The INSERT statement conflicted with the FOREIGN KEY constraint "FK_m_b_id". The conflict occurred in database "db", table "dbo.m_a", column 'id'.`, ErrForeignKeyViolation, "m_b", "FK_m_b_id", "id"},
+		{Postgres, `pq: duplicate key value violates unique constraint "m_b_alt"`, ErrUniqueViolation, "m_b", "m_b_alt", "x,y"},
+		{SQLServer, "mssql: Violation of PRIMARY KEY constraint 'PK__m_b__3213E83F'. Cannot insert duplicate key in object 'dbo.m_b'. The duplicate key value is (1).", ErrUniqueViolation, "m_b", "PK__m_b__3213E83F", "tid"},
+	} {
+		table.SetDBDriver(c.driver)
+		err := table.constraintError(errors.New(c.msg))
+		var ce *ConstraintError
+		if !errors.As(err, &ce) || ce.Kind != c.kind || ce.Table != c.table || ce.Constraint != c.constraint || strings.Join(ce.Columns, ",") != c.columns {
+			t.Errorf("%s: %#v", c.msg, err)
+		}
+	}
+
+	// the columns in the detail of Postgres take precedence over the name
+	table.SetDBDriver(Postgres)
+	for _, err := range []error{
+		&detailError{msg: `pq: duplicate key value violates unique constraint "m_b_alt"`, Detail: `Key (x)=(a) already exists.`},
+		fmt.Errorf("insert: %w", detailMethodError{detailError{msg: `pq: duplicate key value violates unique constraint "m_b_alt"`, Detail: `Key ("x")=(a) already exists.`}}),
+	} {
+		var ce *ConstraintError
+		if !errors.As(table.constraintError(err), &ce) || strings.Join(ce.Columns, ",") != "x" {
+			t.Errorf("%#v", ce)
+		}
+	}
+
+	table.SetDBDriver(SQLRaw)
+	if err := table.constraintError(errors.New("UNIQUE constraint failed: m_b.x")); errors.Is(err, ErrConstraint) {
+		t.Errorf("%v", err)
+	}
+}
+
+// detailError is shaped as the errors of pq and pgx
+type detailError struct {
+	msg    string
+	Detail string
+}
+
+func (e *detailError) Error() string { return e.msg }
+
+type detailMethodError struct{ detailError }
+
+func (e detailMethodError) Error() string  { return e.msg }
+func (e detailMethodError) Detail() string { return e.detailError.Detail }
@@ -0,0 +1,94 @@
+package godbi
+
+import (
+	"sort"
+	"strings"
+)
+
+// existsCondition is an EXISTS or NOT EXISTS subquery in extra, which
+// selectCondition renders with its values
+type existsCondition struct {
+	sql    string
+	values []any
+}
+
+// existsExtra returns extra with the conditions on nextpages rendered as
+// subqueries on table t, named name in the query, whose aliases start with
+// prefix. A key of extra naming
+// the subname of a nextpage, with a map of conditions on its atom, keeps
+// the rows having a related row meeting them. The key prefixed by ! keeps
+// the rows having none. The conditions may in turn name the nextpages of
+// the nextpage.
+func (m *Molecule) existsExtra(atom string, t *Table, name, prefix string, nextpages []*Connection, extra map[string]any) (map[string]any, error) {
+	var output map[string]any
+	for key, value := range extra {
+		subname, negate := strings.CutPrefix(key, "!")
+		var p *Connection
+		for _, next := range nextpages {
+			if next.Subname() == subname {
+				p = next
+			}
+		}
+		cond, isMap := value.(map[string]any)
+		if p == nil || !(isMap || value == nil) {
+			if negate {
+				return nil, errorFilter(key, atom)
+			}
+			continue
+		}
+		if _, ok := p.RelateExtra["ALL"]; ok || len(p.RelateExtra) == 0 {
+			return nil, errorFilter(key, atom)
+		}
+		pAtom := m.GetAtom(p.AtomName)
+		if pAtom == nil {
+			return nil, errorAtomNotFound(p.AtomName)
+		}
+		pAction := pAtom.GetAction(p.ActionName)
+		if pAction == nil {
+			return nil, errorActionNotFound(p.ActionName, p.AtomName)
+		}
+
+		pTable := &pAtom.Table
+		alias := prefix + "_" + subname
+		cond, err := m.existsExtra(p.AtomName, pTable, alias, alias, pAction.GetBaseAction().Nextpages, cond)
+		if err != nil {
+			return nil, err
+		}
+
+		labels := make([]string, 0, len(p.RelateExtra))
+		for label := range p.RelateExtra {
+			labels = append(labels, label)
+		}
+		sort.Strings(labels)
+		var ons []string
+		for _, label := range labels {
+			column := label
+			for _, col := range t.Columns {
+				if col.Label == label {
+					column = col.ColumnName
+				}
+			}
+			ons = append(ons, pTable.quote(alias)+"."+pTable.quote(p.RelateExtra[label])+" = "+t.quote(name)+"."+t.quote(column))
+		}
+		sql := "EXISTS (SELECT 1 FROM " + pTable.quotedName() + " " + pTable.quote(alias) + " WHERE " + strings.Join(ons, " AND ")
+		var values []any
+		if hasValue(cond) {
+			where, whereValues := pTable.selectCondition(cond, alias)
+			sql += " AND " + where
+			values = whereValues
+		}
+		sql += ")"
+		if negate {
+			sql = "NOT " + sql
+		}
+
+		if output == nil {
+			output = cloneMap(extra)
+		}
+		output[key] = existsCondition{sql: sql, values: values}
+	}
+	if output == nil {
+		return extra, nil
+	}
+	return output, nil
+}
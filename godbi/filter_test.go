@@ -0,0 +1,104 @@
+package godbi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestExistsFilter(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE customer (cid integer primary key, name varchar(8))`,
+		`CREATE TABLE orders (id integer primary key, cid int, status varchar(8))`,
+		`CREATE TABLE item (iid integer primary key, oid int, sku varchar(8))`,
+		`INSERT INTO customer VALUES (1, 'a'), (2, 'b'), (3, 'c')`,
+		`INSERT INTO orders VALUES (1, 1, 'paid'), (2, 1, 'open'), (3, 2, 'paid')`,
+		`INSERT INTO item VALUES (1, 1, 'X'), (2, 1, 'Y'), (3, 2, 'Y'), (4, 3, 'Z')`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	molecule := new(Molecule)
+	err = json.Unmarshal([]byte(`{"dbDriver":2,"atoms":[
+{"atomName":"customer","tableName":"customer","pks":["cid"],"columns":[{"columnName":"cid","typeName":"int","label":"cid"},{"columnName":"name","typeName":"string","label":"name"}],
+ "actions":[{"actionName":"topics","nextpages":[{"atomName":"orders","actionName":"topics","relateExtra":{"cid":"cid"},"marker":"orders"}]}]},
+{"atomName":"orders","tableName":"orders","pks":["id"],"columns":[{"columnName":"id","typeName":"int","label":"id"},{"columnName":"cid","typeName":"int","label":"cid"},{"columnName":"status","typeName":"string","label":"status"}],
+ "actions":[{"actionName":"topics","nextpages":[
+	{"atomName":"item","actionName":"topics","relateExtra":{"id":"oid"},"marker":"items"},
+	{"atomName":"customer","actionName":"edit","relateArgs":{"cid":"cid"},"marker":"customer","dimension":"one"}]}]},
+{"atomName":"item","tableName":"item","pks":["iid"],"columns":[{"columnName":"iid","typeName":"int","label":"iid"},{"columnName":"oid","typeName":"int","label":"oid"},{"columnName":"sku","typeName":"string","label":"sku"}]}]}`), molecule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ids := func(lists []any, label string) []any {
+		var out []any
+		for _, item := range lists {
+			out = append(out, item.(map[string]any)[label])
+		}
+		return out
+	}
+
+	for _, c := range []struct {
+		atom  string
+		extra map[string]any
+		label string
+		ids   []any
+	}{
+		{"orders", map[string]any{"items": map[string]any{"sku": "X"}}, "id", []any{1}},
+		{"orders", map[string]any{"items": map[string]any{"sku": []string{"Y", "Z"}}, "status": "paid"}, "id", []any{1, 3}},
+		{"orders", map[string]any{"!items": map[string]any{"sku": "Y"}}, "id", []any{3}},
+		{"customer", map[string]any{"!orders": nil}, "cid", []any{3}},
+		{"customer", map[string]any{"orders": map[string]any{"status": "open"}}, "cid", []any{1}},
+		{"customer", map[string]any{"orders": map[string]any{"!items": map[string]any{"sku": "X"}}}, "cid", []any{1, 2}},
+		{"customer", map[string]any{"orders": map[string]any{"items": map[string]any{"sku": "Z"}}}, "cid", []any{2}},
+	} {
+		lists, err := molecule.RunContext(ctx, db, c.atom, "topics", &RunOption{Extra: c.extra})
+		if got := ids(lists, c.label); err != nil || len(got) != len(c.ids) || (len(got) > 0 && got[0] != c.ids[0]) || (len(got) > 1 && got[1] != c.ids[1]) {
+			t.Errorf("%#v: %v %v", c.extra, err, got)
+		}
+	}
+
+	// the nextpages of the filtered rows are read as usual
+	lists, err := molecule.RunContext(ctx, db, "orders", "topics", &RunOption{Extra: map[string]any{"items": map[string]any{"sku": "X"}}})
+	if err != nil || len(lists) != 1 || len(lists[0].(map[string]any)["items"].([]any)) != 2 {
+		t.Errorf("%v %#v", err, lists)
+	}
+
+	// the nextpage is related by args, not extra
+	for _, extra := range []map[string]any{{"!customer": nil}, {"customer": map[string]any{}}, {"!payments": nil}} {
+		_, err = molecule.RunContext(ctx, db, "orders", "topics", &RunOption{Extra: extra})
+		if !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("%#v: %v", extra, err)
+		}
+	}
+
+	// the results filtered by nextpages are not cached, since they change
+	// with the rows of the nextpage atoms
+	cache := NewMemoryCache(0)
+	molecule.SetResultCache(cache, map[string]time.Duration{"orders": time.Minute})
+	filtered := &RunOption{Extra: map[string]any{"items": map[string]any{"sku": "X"}}}
+	if lists, err = molecule.RunContext(ctx, db, "orders", "topics", filtered); err != nil || len(lists) != 1 {
+		t.Errorf("%v %#v", err, lists)
+	}
+	if _, err = db.Exec(`INSERT INTO item VALUES (5, 3, 'X')`); err != nil {
+		t.Fatal(err)
+	}
+	if lists, err = molecule.RunContext(ctx, db, "orders", "topics", filtered); err != nil || len(lists) != 2 || cache.Len() != 0 {
+		t.Errorf("%v %#v %d", err, lists, cache.Len())
+	}
+	if _, err = molecule.RunContext(ctx, db, "orders", "topics", &RunOption{Extra: map[string]any{"status": "paid"}}); err != nil || cache.Len() != 1 {
+		t.Errorf("%v %d", err, cache.Len())
+	}
+}
@@ -0,0 +1,150 @@
+package godbi
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+// goldenDriver records the statements, so SQL can be tested without server.
+// A query selecting one column, or returning the auto id, gets one row of 1;
+// any other query gets no row.
+type goldenDriver struct {
+	log []string
+}
+
+var golden = &goldenDriver{}
+
+func init() {
+	sql.Register("golden", golden)
+}
+
+func (d *goldenDriver) Open(name string) (driver.Conn, error) {
+	return &goldenConn{d}, nil
+}
+
+type goldenConn struct {
+	d *goldenDriver
+}
+
+func (c *goldenConn) Prepare(query string) (driver.Stmt, error) {
+	return &goldenStmt{c.d, query}, nil
+}
+
+func (c *goldenConn) Close() error              { return nil }
+func (c *goldenConn) Begin() (driver.Tx, error) { return c, nil }
+func (c *goldenConn) Commit() error             { return nil }
+func (c *goldenConn) Rollback() error           { return nil }
+
+type goldenStmt struct {
+	d     *goldenDriver
+	query string
+}
+
+func (s *goldenStmt) Close() error  { return nil }
+func (s *goldenStmt) NumInput() int { return -1 }
+
+func (s *goldenStmt) record(args []driver.Value) {
+	s.d.log = append(s.d.log, s.query+"\n-- "+fmt.Sprint(args))
+}
+
+func (s *goldenStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.record(args)
+	return driver.RowsAffected(1), nil
+}
+
+var reOneColumn = regexp.MustCompile(`^SELECT [^,]+\sFROM|OUTPUT INSERTED`)
+
+func (s *goldenStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.record(args)
+	return &goldenRows{n: reOneColumn.MatchString(s.query)}, nil
+}
+
+type goldenRows struct {
+	n bool
+}
+
+func (r *goldenRows) Columns() []string { return []string{"id"} }
+func (r *goldenRows) Close() error      { return nil }
+
+func (r *goldenRows) Next(dest []driver.Value) error {
+	if !r.n {
+		return io.EOF
+	}
+	r.n = false
+	dest[0] = int64(1)
+	return nil
+}
+
+func TestGoldenSQLServer(t *testing.T) {
+	db, err := sql.Open("golden", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	table := &Table{
+		TableName: "m_a",
+		Pks:       []string{"id"},
+		IDAuto:    "id",
+		Uniques:   []string{"x"},
+		Fks:       []*Fk{{FkTable: "m_b", FkColumn: "id", Column: "bid"}},
+		Columns: []*Col{
+			{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+			{ColumnName: "x", Label: "x", TypeName: "string", Notnull: true},
+			{ColumnName: "y", Label: "y", TypeName: "bool"},
+			{ColumnName: "bid", Label: "bid", TypeName: "int"},
+		},
+	}
+	table.SetDBDriver(SQLServer)
+
+	cases := []struct {
+		name   string
+		action Capability
+		args   map[string]any
+		extra  map[string]any
+	}{
+		{"insert", &Insert{}, map[string]any{"x": "a", "y": true}, nil},
+		{"insert returning", &Insert{Returning: true}, map[string]any{"x": "a"}, nil},
+		{"update", &Update{}, map[string]any{"id": 1, "x": "b", "y": false}, nil},
+		{"insupd", &Insupd{}, map[string]any{"x": "a", "y": true}, nil},
+		{"edit", &Edit{}, map[string]any{"id": 1}, nil},
+		{"topics", &Topics{Totalforce: -1}, map[string]any{"pagesize": 10, "sortby": "x"}, map[string]any{"y": 1}},
+		{"delete", &Delete{}, map[string]any{"id": 1}, nil},
+		{"delecs", &Delecs{}, map[string]any{"id": 1}, nil},
+	}
+
+	var output []string
+	for _, c := range cases {
+		golden.log = nil
+		if _, err = c.action.RunActionContext(context.Background(), db, table, c.args, c.extra); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		output = append(output, "-- "+c.name)
+		output = append(output, golden.log...)
+	}
+	got := strings.Join(output, "\n") + "\n"
+
+	fn := "sqlserver.golden"
+	if *updateGolden {
+		if err = os.WriteFile(fn, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bs, err := os.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != got {
+		t.Errorf("SQL differs from %s:\n%s", fn, got)
+	}
+}
@@ -0,0 +1,106 @@
+package godbi
+
+import (
+	"context"
+)
+
+// BeforeHook runs before the action with its input, which it may change,
+// e.g. to normalize values or stamp columns. An error aborts the action.
+type BeforeHook func(ctx context.Context, atom, action string, args, extra map[string]any) error
+
+// AfterHook runs after the action succeeds, with its input and output,
+// e.g. to publish events. An error fails the action, which is rolled back
+// if run in a transaction.
+type AfterHook func(ctx context.Context, atom, action string, args map[string]any, lists []any) error
+
+// Hooks are Go functions run around an action. Either may be nil.
+type Hooks struct {
+	Before BeforeHook
+	After  AfterHook
+}
+
+// AddHooks adds hooks to the action, or to all actions if action is "*".
+// Hooks run in the order added, those of "*" first. They should be added
+// before the atom runs, or before the molecule is compiled.
+func (a *Atom) AddHooks(action string, hooks Hooks) {
+	if a.hooks == nil {
+		a.hooks = make(map[string][]Hooks)
+	}
+	a.hooks[action] = append(a.hooks[action], hooks)
+}
+
+// AddHooks adds hooks to the action of the atom. Atom "*" means all atoms,
+// and action "*" all actions.
+func (m *Molecule) AddHooks(atom, action string, hooks Hooks) error {
+	for _, a := range m.Atoms {
+		if atom != "*" && a.AtomName != atom {
+			continue
+		}
+		if action != "*" && a.GetAction(action) == nil {
+			if atom == "*" {
+				continue
+			}
+			return errorActionNotFound(action, atom)
+		}
+		a.AddHooks(action, hooks)
+		if atom != "*" {
+			return nil
+		}
+	}
+	if atom != "*" {
+		return errorAtomNotFound(atom)
+	}
+	return nil
+}
+
+// hooksOf returns the hooks of action
+func (a *Atom) hooksOf(action string) []Hooks {
+	if a.hooks == nil {
+		return nil
+	}
+	all := a.hooks["*"]
+	if action == "*" {
+		return all
+	}
+	return append(all[:len(all):len(all)], a.hooks[action]...)
+}
+
+// runBefore runs the before hooks on args and extra, which they change in
+// place. A nil input is replaced by a new map, if the hooks add to it.
+func (a *Atom) runBefore(ctx context.Context, action string, hooks []Hooks, args, extra map[string]any) (map[string]any, map[string]any, error) {
+	newArgs, newExtra := args, extra
+	if newArgs == nil {
+		newArgs = make(map[string]any)
+	}
+	if newExtra == nil {
+		newExtra = make(map[string]any)
+	}
+	for _, h := range hooks {
+		if h.Before == nil {
+			continue
+		}
+		if err := h.Before(ctx, a.AtomName, action, newArgs, newExtra); err != nil {
+			return nil, nil, err
+		}
+	}
+	if args == nil && len(newArgs) == 0 {
+		newArgs = nil
+	}
+	if extra == nil && len(newExtra) == 0 {
+		newExtra = nil
+	}
+	return newArgs, newExtra, nil
+}
+
+// runAfter runs the after hooks
+func (a *Atom) runAfter(ctx context.Context, action string, hooks []Hooks, args map[string]any, lists []any) error {
+	for _, h := range hooks {
+		if h.After == nil {
+			continue
+		}
+		if err := h.After(ctx, a.AtomName, action, args, lists); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package godbi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type userKey struct{}
+
+func TestHooks(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec(`CREATE TABLE m_a (id integer primary key autoincrement, email varchar(32) not null, updated_by varchar(8))`); err != nil {
+		t.Fatal(err)
+	}
+
+	molecule := &Molecule{
+		Atoms: []*Atom{{
+			AtomName: "m_a",
+			Table: Table{TableName: "m_a", Pks: []string{"id"}, IDAuto: "id", Columns: []*Col{
+				{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+				{ColumnName: "email", Label: "email", TypeName: "string"},
+				{ColumnName: "updated_by", Label: "updated_by", TypeName: "string"}}},
+			Actions: []Capability{
+				&Insert{Action: Action{ActionName: "insert", IsDo: true}},
+				&Update{Action: Action{ActionName: "update", IsDo: true}},
+				&Delete{Action: Action{ActionName: "delete", IsDo: true}},
+				&Edit{Action: Action{ActionName: "edit"}}},
+		}},
+		DBDriver: SQLite,
+	}
+
+	var befores int
+	err = molecule.AddHooks("m_a", "insert", Hooks{Before: func(ctx context.Context, atom, action string, args, extra map[string]any) error {
+		befores++
+		email, _ := args["email"].(string)
+		if email == "" {
+			return errors.New("email required")
+		}
+		args["email"] = strings.ToLower(strings.TrimSpace(email))
+		return nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = molecule.AddHooks("*", "*", Hooks{Before: func(ctx context.Context, atom, action string, args, extra map[string]any) error {
+		if action == "insert" || action == "update" {
+			args["updated_by"] = ctx.Value(userKey{})
+		}
+		return nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []string
+	err = molecule.AddHooks("m_a", "delete", Hooks{After: func(ctx context.Context, atom, action string, args map[string]any, lists []any) error {
+		events = append(events, atom+"."+action+":"+args["id"].(string))
+		return nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = molecule.AddHooks("m_c", "insert", Hooks{}); !errors.Is(err, ErrAtomNotFound) {
+		t.Errorf("%v", err)
+	}
+	if err = molecule.AddHooks("m_a", "stmt", Hooks{}); !errors.Is(err, ErrActionNotFound) {
+		t.Errorf("%v", err)
+	}
+
+	compiled, err := molecule.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), userKey{}, "alice")
+
+	lists, err := compiled.RunContext(ctx, db, "m_a", "insert", &RunOption{Args: map[string]any{"email": " Alice@Example.COM "}})
+	if err != nil || len(lists) != 1 {
+		t.Fatalf("%v %#v", err, lists)
+	}
+	item := lists[0].(map[string]any)
+	if item["email"] != "alice@example.com" || item["updated_by"] != "alice" {
+		t.Errorf("%#v", item)
+	}
+
+	_, err = compiled.RunContext(ctx, db, "m_a", "insert", &RunOption{Args: map[string]any{"email": "", "updated_by": "x"}})
+	if err == nil || !strings.Contains(err.Error(), "email required") {
+		t.Errorf("%v", err)
+	}
+
+	// on the atom, the hooks run for each row
+	befores = 0
+	lists, err = compiled.GetAtom("m_a").RunAtomContext(ctx, db, "insert", []any{map[string]any{"email": "B@x"}, map[string]any{"email": "C@x"}})
+	if err != nil || befores != 2 || len(lists) != 2 || lists[1].(map[string]any)["email"] != "c@x" {
+		t.Errorf("%v %d %#v", err, befores, lists)
+	}
+
+	_, err = compiled.RunContext(ctx, db, "m_a", "delete", &RunOption{Args: map[string]any{"id": "1"}})
+	if err != nil || len(events) != 1 || events[0] != "m_a.delete:1" {
+		t.Errorf("%v %v", err, events)
+	}
+
+	// an after hook fails the action, which is rolled back in a transaction
+	molecule.GetAtom("m_a").AddHooks("delete", Hooks{After: func(ctx context.Context, atom, action string, args map[string]any, lists []any) error {
+		return errors.New("publish failed")
+	}})
+	_, err = molecule.RunTxContext(ctx, db, "m_a", "delete", &RunOption{Args: map[string]any{"id": "2"}}, nil)
+	if err == nil || len(events) != 2 {
+		t.Errorf("%v %v", err, events)
+	}
+	lists, err = molecule.RunContext(context.Background(), db, "m_a", "edit", &RunOption{Args: map[string]any{"id": "2"}})
+	if err != nil || len(lists) != 1 {
+		t.Errorf("%v %#v", err, lists)
+	}
+}
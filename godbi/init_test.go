@@ -6,6 +6,7 @@ import (
 	"os"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
 )
 
 func getdb() (*sql.DB, error) {
@@ -21,3 +22,7 @@ func getdb() (*sql.DB, error) {
 	}
 	return sql.Open("mysql", dbUser+":"+dbPass+"@/"+dbName)
 }
+
+func getsqlite() (*sql.DB, error) {
+	return sql.Open("sqlite3", ":memory:")
+}
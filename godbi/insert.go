@@ -8,6 +8,9 @@ import (
 // Insert struct for table insert
 type Insert struct {
 	Action
+	// Returning: if true, return the whole persisted row including
+	// server-side defaults, instead of the input data plus IDAuto
+	Returning bool `json:"returning,omitempty" hcl:"returning,optional"`
 }
 
 var _ Capability = (*Insert)(nil)
@@ -23,11 +26,22 @@ func (i *Insert) RunActionContext(ctx context.Context, db *sql.DB, t *Table, arg
 		return nil, err
 	}
 
-	fieldValues, allAuto := t.getFv(args, i.getAllowed())
+	fieldValues, allAuto, err := t.getFv(args, i.getAllowed())
+	if err != nil {
+		return nil, err
+	}
 	if !allAuto && !hasValue(fieldValues) {
 		return nil, errorEmptyInput(t.TableName)
 	}
 
+	if i.Returning {
+		row, err := t.insertReturningContext(ctx, db, fieldValues)
+		if err != nil {
+			return nil, err
+		}
+		return fromFv(row), nil
+	}
+
 	autoID, err := t.insertHashContext(ctx, db, fieldValues)
 	if err != nil {
 		return nil, err
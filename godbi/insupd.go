@@ -8,6 +8,8 @@ import (
 // Insupd struct for table update, if not existing according to the unique key, do insert
 type Insupd struct {
 	Action
+	// Returning: if true, return the whole persisted row
+	Returning bool `json:"returning,omitempty" hcl:"returning,optional"`
 }
 
 var _ Capability = (*Insupd)(nil)
@@ -21,15 +23,21 @@ func (i *Insupd) RunActionContext(ctx context.Context, db *sql.DB, t *Table, arg
 		return nil, err
 	}
 
-	fieldValues, allAuto := t.getFv(args, i.getAllowed())
+	fieldValues, allAuto, err := t.getFv(args, i.getAllowed())
+	if err != nil {
+		return nil, err
+	}
 	if !allAuto && !hasValue(fieldValues) {
 		return nil, errorEmptyInput(t.TableName)
 	}
 
-	changed, err := t.insupdTableContext(ctx, db, fieldValues)
+	changed, row, err := t.insupdReturningContext(ctx, db, fieldValues, i.Returning)
 	if err != nil {
 		return nil, err
 	}
+	if i.Returning {
+		return fromFv(row), nil
+	}
 
 	if t.IDAuto != "" {
 		fieldValues[t.IDAuto] = changed
@@ -0,0 +1,180 @@
+package godbi
+
+import (
+	"context"
+	"fmt"
+)
+
+// Limits bound a molecule run, which may explode into many queries by
+// recursive atoms and cyclic connections. A zero limit means no limit.
+type Limits struct {
+	// MaxDepth is the maximum nesting of actions through prepares and
+	// nextpages, the called action being 1
+	MaxDepth int `json:"maxDepth,omitempty" hcl:"maxDepth,optional"`
+	// MaxQueries is the maximum number of actions run
+	MaxQueries int `json:"maxQueries,omitempty" hcl:"maxQueries,optional"`
+	// MaxRows is the maximum number of rows returned by the actions
+	MaxRows int `json:"maxRows,omitempty" hcl:"maxRows,optional"`
+	// Truncate makes a read action beyond the limits return no or fewer
+	// rows, instead of the error ErrLimit. Do-actions always fail.
+	Truncate bool `json:"truncate,omitempty" hcl:"truncate,optional"`
+}
+
+// RunStats reports a molecule run
+type RunStats struct {
+	// Queries is the number of actions run
+	Queries int
+	// Rows is the number of rows returned by the actions
+	Rows int
+	// Depth is the maximum nesting of actions reached
+	Depth int
+	// Truncated tells if the result is truncated by the limits
+	Truncated bool
+	// Paging is the pagination of the called Topics: the page size, page
+	// number, total number of rows and of pages, as far as they are known
+	Paging map[string]any
+}
+
+// runState counts the actions and rows of a run
+type runState struct {
+	limits *Limits
+	stats  *RunStats
+}
+
+// runLevel is an action on the chain of prepares and nextpages
+type runLevel struct {
+	depth  int
+	step   string
+	input  string
+	parent *runLevel
+}
+
+type runStateKey struct{}
+
+type runLevelKey struct{}
+
+// withRunState returns ctx carrying the state of a new run
+func (m *Molecule) withRunState(ctx context.Context, opt *RunOption) context.Context {
+	limits := m.Limits
+	var stats *RunStats
+	if opt != nil {
+		if opt.Limits != nil {
+			limits = opt.Limits
+		}
+		stats = opt.Stats
+	}
+	if stats == nil {
+		stats = new(RunStats)
+	}
+	return context.WithValue(ctx, runStateKey{}, &runState{limits: limits, stats: stats})
+}
+
+func runStateFrom(ctx context.Context) *runState {
+	state, _ := ctx.Value(runStateKey{}).(*runState)
+	return state
+}
+
+// enterLevel returns ctx carrying the level of action step on input, or an
+// error if the level is too deep, or repeats one on the chain. If isDo is
+// false and the limits truncate, it returns nil ctx to skip the action.
+func enterLevel(ctx context.Context, step string, isDo bool, args, extra map[string]any) (context.Context, error) {
+	parent, _ := ctx.Value(runLevelKey{}).(*runLevel)
+	level := &runLevel{step: step, input: fmt.Sprint(args, extra), parent: parent}
+	if parent != nil {
+		level.depth = parent.depth
+	}
+	level.depth++
+
+	state := runStateFrom(ctx)
+	if state != nil && state.stats.Depth < level.depth {
+		state.stats.Depth = level.depth
+	}
+
+	for p := parent; p != nil; p = p.parent {
+		if p.step == step && p.input == level.input {
+			if state.truncate(isDo) {
+				return nil, nil
+			}
+			return nil, errorCycle(step)
+		}
+	}
+	if state != nil && state.limits != nil && state.limits.MaxDepth > 0 && level.depth > state.limits.MaxDepth {
+		if state.truncate(isDo) {
+			return nil, nil
+		}
+		return nil, errorLimit("depth", state.limits.MaxDepth)
+	}
+	return context.WithValue(ctx, runLevelKey{}, level), nil
+}
+
+// levelDepth returns the depth of the action running in ctx
+func levelDepth(ctx context.Context) int {
+	if level, _ := ctx.Value(runLevelKey{}).(*runLevel); level != nil {
+		return level.depth
+	}
+	return 0
+}
+
+// descend records depth reached by a read not running as an action. It
+// returns false if the read should stop, or an error if it is too deep.
+func (s *runState) descend(depth int) (bool, error) {
+	if s == nil {
+		return true, nil
+	}
+	if s.stats.Depth < depth {
+		s.stats.Depth = depth
+	}
+	if s.limits != nil && s.limits.MaxDepth > 0 && depth > s.limits.MaxDepth {
+		if s.truncate(false) {
+			return false, nil
+		}
+		return false, errorLimit("depth", s.limits.MaxDepth)
+	}
+	return true, nil
+}
+
+// truncate tells if the read action is truncated instead of failing,
+// marking the result as truncated
+func (s *runState) truncate(isDo bool) bool {
+	if s == nil || s.limits == nil || !s.limits.Truncate || isDo {
+		return false
+	}
+	s.stats.Truncated = true
+	return true
+}
+
+// query counts a query before it runs. It returns false if the read action
+// should be skipped, or an error if the query is over the limits.
+func (s *runState) query(isDo bool) (bool, error) {
+	if s == nil {
+		return true, nil
+	}
+	if s.limits != nil && s.limits.MaxRows > 0 && s.stats.Rows >= s.limits.MaxRows && s.truncate(isDo) {
+		return false, nil
+	}
+	if s.limits != nil && s.limits.MaxQueries > 0 && s.stats.Queries >= s.limits.MaxQueries {
+		if s.truncate(isDo) {
+			return false, nil
+		}
+		return false, errorLimit("queries", s.limits.MaxQueries)
+	}
+	s.stats.Queries++
+	return true, nil
+}
+
+// rows counts the rows of a query, returning those within the limits
+func (s *runState) rows(isDo bool, data []any) ([]any, error) {
+	if s == nil {
+		return data, nil
+	}
+	s.stats.Rows += len(data)
+	if s.limits == nil || s.limits.MaxRows <= 0 || s.stats.Rows <= s.limits.MaxRows {
+		return data, nil
+	}
+	if !s.truncate(isDo) {
+		return nil, errorLimit("rows", s.limits.MaxRows)
+	}
+	over := s.stats.Rows - s.limits.MaxRows
+	s.stats.Rows = s.limits.MaxRows
+	return data[:len(data)-over], nil
+}
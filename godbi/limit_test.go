@@ -0,0 +1,105 @@
+package godbi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestLimits(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE m_a (id integer primary key, next int)`,
+		// 1 > 2 > 3 > 4, and 5 > 6 > 5
+		`INSERT INTO m_a VALUES (1, 2), (2, 3), (3, 4), (4, NULL), (5, 6), (6, 5)`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	molecule := new(Molecule)
+	err = json.Unmarshal([]byte(`{"dbDriver":2,"limits":{"maxDepth":10},"atoms":[
+{"atomName":"m_a","tableName":"m_a","pks":["id"],"columns":[{"columnName":"id","typeName":"int","label":"id"},{"columnName":"next","typeName":"int","label":"next"}],
+ "actions":[
+	{"actionName":"edit","nextpages":[{"atomName":"m_a","actionName":"edit","relateArgs":{"next":"id"},"marker":"node","dimension":"one"}]},
+	{"actionName":"insert","nextpages":[{"atomName":"m_a","actionName":"insert","marker":"children"}]}]}]}`), molecule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if molecule.Limits == nil || molecule.Limits.MaxDepth != 10 {
+		t.Fatalf("%#v", molecule.Limits)
+	}
+	ctx := context.Background()
+	depth := func(item any) int {
+		n := 0
+		for item != nil {
+			n++
+			item = item.(map[string]any)["node"]
+		}
+		return n
+	}
+
+	stats := new(RunStats)
+	lists, err := molecule.RunContext(ctx, db, "m_a", "edit", &RunOption{Args: map[string]any{"id": 1}, Stats: stats})
+	if err != nil || depth(lists[0]) != 4 || stats.Queries != 4 || stats.Rows != 4 || stats.Depth != 4 || stats.Truncated {
+		t.Errorf("%v %#v %#v", err, lists, stats)
+	}
+
+	// the loop of 5 and 6
+	_, err = molecule.RunContext(ctx, db, "m_a", "edit", &RunOption{Args: map[string]any{"id": 5}})
+	var pathErr *PathError
+	if !errors.Is(err, ErrCycle) || !errors.As(err, &pathErr) || len(pathErr.Path) != 3 {
+		t.Errorf("%v", err)
+	}
+	stats = new(RunStats)
+	lists, err = molecule.RunContext(ctx, db, "m_a", "edit", &RunOption{Args: map[string]any{"id": 5}, Limits: &Limits{Truncate: true}, Stats: stats})
+	if err != nil || depth(lists[0]) != 2 || !stats.Truncated {
+		t.Errorf("%v %#v %#v", err, lists, stats)
+	}
+
+	for _, limits := range []*Limits{{MaxDepth: 2}, {MaxQueries: 2}, {MaxRows: 2}} {
+		_, err = molecule.RunContext(ctx, db, "m_a", "edit", &RunOption{Args: map[string]any{"id": 1}, Limits: limits})
+		if !errors.Is(err, ErrLimit) {
+			t.Errorf("%#v: %v", limits, err)
+		}
+
+		truncated := *limits
+		truncated.Truncate = true
+		stats = new(RunStats)
+		lists, err = molecule.RunContext(ctx, db, "m_a", "edit", &RunOption{Args: map[string]any{"id": 1}, Limits: &truncated, Stats: stats})
+		if err != nil || depth(lists[0]) != 2 || !stats.Truncated || stats.Queries > 2 || stats.Rows != 2 {
+			t.Errorf("%#v: %v %#v %#v", limits, err, lists, stats)
+		}
+	}
+
+	// do-actions are not truncated
+	args := map[string]any{"id": 7, "children": []any{map[string]any{"id": 8}}}
+	_, err = molecule.RunTxContext(ctx, db, "m_a", "insert", &RunOption{Args: args, Limits: &Limits{MaxDepth: 1, Truncate: true}}, nil)
+	if !errors.Is(err, ErrLimit) {
+		t.Errorf("%v", err)
+	}
+	lists, err = molecule.RunContext(ctx, db, "m_a", "insert", &RunOption{Args: args})
+	if err != nil || len(lists) != 1 {
+		t.Errorf("%v %#v", err, lists)
+	}
+
+	bs, err := molecule.MarshalHCL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := new(Molecule)
+	if err = m2.UnmarshalHCL(bs); err != nil {
+		t.Fatal(err)
+	}
+	if m2.Limits == nil || m2.Limits.MaxDepth != 10 {
+		t.Errorf("%s", bs)
+	}
+}
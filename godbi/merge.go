@@ -27,6 +27,72 @@ func cloneArgs(args any) any {
 	return nil
 }
 
+// deepCopy returns a copy of args, copying maps and slices in depth
+func deepCopy(args any) any {
+	switch t := args.(type) {
+	case map[string]any:
+		if t == nil {
+			return t
+		}
+		hash := make(map[string]any, len(t))
+		for k, v := range t {
+			hash[k] = deepCopy(v)
+		}
+		return hash
+	case []map[string]any:
+		if t == nil {
+			return t
+		}
+		lists := make([]map[string]any, len(t))
+		for i, item := range t {
+			lists[i] = deepCopy(item).(map[string]any)
+		}
+		return lists
+	case []any:
+		if t == nil {
+			return t
+		}
+		lists := make([]any, len(t))
+		for i, item := range t {
+			lists[i] = deepCopy(item)
+		}
+		return lists
+	default:
+	}
+	return args
+}
+
+// resetArgs restores the maps in args, in place, to those in saved, which
+// is a deep copy of args taken before they were changed
+func resetArgs(args, saved any) {
+	switch t := args.(type) {
+	case map[string]any:
+		if hash, ok := saved.(map[string]any); ok && t != nil {
+			clear(t)
+			for k, v := range hash {
+				t[k] = deepCopy(v)
+			}
+		}
+	case []map[string]any:
+		if lists, ok := saved.([]map[string]any); ok && len(lists) == len(t) {
+			for i, item := range t {
+				resetArgs(item, lists[i])
+			}
+		}
+	case []any:
+		if lists, ok := saved.([]any); ok && len(lists) == len(t) {
+			for i, item := range t {
+				if _, ok := item.(map[string]any); ok {
+					resetArgs(item, lists[i])
+				} else {
+					t[i] = deepCopy(lists[i])
+				}
+			}
+		}
+	default:
+	}
+}
+
 func mergeArgsMap(args any, item map[string]any, force ...bool) any {
 	if args == nil {
 		return item
@@ -113,3 +179,27 @@ func mergeArgs(args, items any, force ...bool) any {
 	}
 	return args
 }
+
+// deleteKeys removes keys, e.g. all columns of a primary key, from args
+func deleteKeys(args any, keys []string) {
+	del := func(item map[string]any) {
+		for _, k := range keys {
+			delete(item, k)
+		}
+	}
+	switch t := args.(type) {
+	case map[string]any:
+		del(t)
+	case []any:
+		for _, s := range t {
+			if item, ok := s.(map[string]any); ok {
+				del(item)
+			}
+		}
+	case []map[string]any:
+		for _, s := range t {
+			del(s)
+		}
+	default:
+	}
+}
@@ -3,6 +3,11 @@ package godbi
 import (
 	"context"
 	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/genelet/horizon/dethcl"
 )
 
 type PreStopper interface {
@@ -18,9 +23,43 @@ type Stopper interface {
 type Molecule struct {
 	Atoms    []*Atom `json:"atoms" hcl:"atoms,block"`
 	DBDriver DBType  `json:"dbDriver" hcl:"dbDriver,optional"`
-	Stopper
-	PreStopper
-	logger Slogger
+	Dialect  string  `json:"dialect,omitempty" hcl:"dialect,optional"`
+	// Timeout bounds a whole run, e.g. 500ms or 2s
+	Timeout string `json:"timeout,omitempty" hcl:"timeout,optional"`
+	// Limits bound each run, unless RunOption has its own
+	Limits     *Limits `json:"limits,omitempty" hcl:"limits,block"`
+	Stopper    `json:"-" hcl:"-"`
+	PreStopper `json:"-" hcl:"-"`
+	logger     Slogger
+	cache      *StmtCache
+	// results caches read actions of the atoms in ttls
+	results ResultCache
+	ttls    map[string]time.Duration
+	// retry is the policy of RunTxContext
+	retry *RetryPolicy
+	// index of atoms by name, built by Compile
+	index map[string]*Atom
+}
+
+// UnmarshalHCL is a HCL unmarshaler. Actions of custom types registered
+// by RegisterCapability are decoded too.
+func (m *Molecule) UnmarshalHCL(bs []byte, labels ...string) error {
+	type molecule Molecule
+	return dethcl.Unmarshal(bs, (*molecule)(m), labels...)
+}
+
+// MarshalHCL is a HCL marshaler. The output is loaded by UnmarshalHCL
+// without loss.
+func (m *Molecule) MarshalHCL() ([]byte, error) {
+	type molecule Molecule
+	tmp := molecule(*m)
+	tmp.Atoms = make([]*Atom, len(m.Atoms))
+	for i, atom := range m.Atoms {
+		copied := *atom
+		copied.Actions = atom.typedActions()
+		tmp.Atoms[i] = &copied
+	}
+	return dethcl.Marshal(&tmp)
 }
 
 // SetLogger sets the logger
@@ -36,13 +75,46 @@ func (m *Molecule) GetLogger() Slogger {
 	return m.logger
 }
 
+// SetStmtCache sets the prepared statement cache shared by all atoms.
+// The cache is optional; without it, statements are prepared every time.
+func (m *Molecule) SetStmtCache(cache *StmtCache) {
+	m.cache = cache
+	for _, atom := range m.Atoms {
+		atom.Table.SetStmtCache(cache)
+	}
+}
+
+// SetResultCache sets the cache of Edit and Topics results. Only atoms
+// in ttls are cached, each for its duration. The results of an atom are
+// invalidated when a do-action, e.g. Insert, Update, Insupd or Delete,
+// runs on it.
+func (m *Molecule) SetResultCache(cache ResultCache, ttls map[string]time.Duration) {
+	m.results = cache
+	m.ttls = ttls
+}
+
+// getDialect returns the dialect registered by name Dialect,
+// or the built-in dialect of DBDriver
+func (m *Molecule) getDialect() Dialect {
+	if m.Dialect != "" {
+		if dialect := GetDialect(m.Dialect); dialect != nil {
+			return dialect
+		}
+	}
+	return m.DBDriver.Dialect()
+}
+
 // GetAtom returns the atom by atom name
 func (m *Molecule) GetAtom(atomName string) *Atom {
+	if m.index != nil {
+		return m.index[atomName]
+	}
 	if m.Atoms != nil {
 		for _, atom := range m.Atoms {
 			if atom.AtomName == atomName {
-				atom.SetDBDriver(m.DBDriver)
+				atom.SetDialect(m.getDialect())
 				atom.Table.logger = m.logger
+				atom.Table.cache = m.cache
 				return atom
 			}
 		}
@@ -56,13 +128,25 @@ type RunOption struct {
 	Extra       map[string]any
 	GlobalArgs  map[string]any
 	GlobalExtra map[string]any
+	// Select chooses the nextpages to follow and the fields to read, by
+	// paths of Subname, e.g. ["id", "items", "items.product", "payments"].
+	// A nil Select follows all nextpages and reads all fields.
+	Select []string
+	// Limits replace those of the molecule for the run
+	Limits *Limits
+	// Stats, if not nil, is filled with the statistics of the run
+	Stats *RunStats
 }
 
 // RunContext runs action by atom and action string names.
 // It returns the searched data and optional error code.
 // atom is the atom name, and action the action name.
+// The run is bounded by Timeout and Limits of the molecule, if any.
 func (m *Molecule) RunContext(ctx context.Context, db *sql.DB, atom, action string, opt *RunOption) ([]any, error) {
-	return m.processContext(false, ctx, db, atom, action, opt)
+	ctx = m.withRunState(ctx, opt)
+	return withTimeout(ctx, "molecule", m.Timeout, func(ctx context.Context) ([]any, error) {
+		return m.processContext(false, ctx, db, atom, action, opt)
+	})
 }
 
 func (m *Molecule) runRecurseContext(ctx context.Context, db *sql.DB, atom, action string, opt *RunOption) ([]any, error) {
@@ -74,12 +158,14 @@ func (m *Molecule) processContext(topRecursive bool, ctx context.Context, db *sq
 	var extra map[string]any
 	var globalArgs map[string]any
 	var globalExtra map[string]any
+	var sel []string
 
 	if opt != nil {
 		args = opt.Args
 		extra = opt.Extra
 		globalArgs = opt.GlobalArgs
 		globalExtra = opt.GlobalExtra
+		sel = opt.Select
 	}
 
 	if hasValue(globalArgs) && hasValue(globalArgs[atom]) {
@@ -94,26 +180,28 @@ func (m *Molecule) processContext(topRecursive bool, ctx context.Context, db *sq
 		}
 	}
 
+	step := atom + "." + action
 	switch t := args.(type) {
 	case map[string]any:
-		return m.execContext(topRecursive, ctx, db, atom, action, t, extra, globalArgs, globalExtra)
+		lists, err := m.execContext(topRecursive, ctx, db, atom, action, t, extra, globalArgs, globalExtra, sel)
+		return lists, withPath(err, step)
 	case []map[string]any:
 		var final []any
-		for _, arg := range t {
-			lists, err := m.execContext(topRecursive, ctx, db, atom, action, arg, extra, globalArgs, globalExtra)
+		for i, arg := range t {
+			lists, err := m.execContext(topRecursive, ctx, db, atom, action, arg, extra, globalArgs, globalExtra, sel)
 			if err != nil {
-				return nil, err
+				return nil, withPath(err, step+"["+strconv.Itoa(i)+"]")
 			}
 			final = append(final, lists...)
 		}
 		return final, nil
 	case []any:
 		var final []any
-		for _, arg := range t {
+		for i, arg := range t {
 			if v, ok := arg.(map[string]any); ok {
-				lists, err := m.execContext(topRecursive, ctx, db, atom, action, v, extra, globalArgs, globalExtra)
+				lists, err := m.execContext(topRecursive, ctx, db, atom, action, v, extra, globalArgs, globalExtra, sel)
 				if err != nil {
-					return nil, err
+					return nil, withPath(err, step+"["+strconv.Itoa(i)+"]")
 				}
 				final = append(final, lists...)
 			}
@@ -122,24 +210,53 @@ func (m *Molecule) processContext(topRecursive bool, ctx context.Context, db *sq
 	default:
 	}
 
-	return m.execContext(topRecursive, ctx, db, atom, action, nil, extra, globalArgs, globalExtra)
+	lists, err := m.execContext(topRecursive, ctx, db, atom, action, nil, extra, globalArgs, globalExtra, sel)
+	return lists, withPath(err, step)
 }
 
 // execContext executes the action logic with fully resolved arguments.
-func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.DB, atom, action string, args, extra, globalArgs, globalExtra map[string]any) ([]any, error) {
+func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.DB, atom, action string, args, extra, globalArgs, globalExtra map[string]any, sel []string) ([]any, error) {
 	atomObj := m.GetAtom(atom)
 	if atomObj == nil {
 		return nil, errorAtomNotFound(atom)
 	}
-	tableObj := atomObj.Table
 	actionObj := atomObj.GetAction(action)
 	if actionObj == nil {
 		return nil, errorActionNotFound(action, atom)
 	}
-	if actionObj.GetBaseAction().IsDo && !hasValue(args) {
+	isDo := actionObj.GetBaseAction().IsDo
+	if isDo && !hasValue(args) {
 		return nil, nil
 	}
+	ctx, err := enterLevel(ctx, atom+"."+action, isDo, args, extra)
+	if ctx == nil {
+		return nil, err
+	}
 
+	hooks := atomObj.hooksOf(action)
+	run := func(ctx context.Context) ([]any, error) {
+		if hooks == nil {
+			return m.actionContext(topRecursive, ctx, db, atom, action, atomObj, actionObj, args, extra, globalArgs, globalExtra, sel)
+		}
+		args, extra, err := atomObj.runBefore(ctx, action, hooks, args, extra)
+		if err != nil {
+			return nil, err
+		}
+		lists, err := m.actionContext(topRecursive, ctx, db, atom, action, atomObj, actionObj, args, extra, globalArgs, globalExtra, sel)
+		if err != nil {
+			return nil, err
+		}
+		return lists, atomObj.runAfter(ctx, action, hooks, args, lists)
+	}
+	// the timeout of the atom bounds each of its actions
+	return withTimeout(ctx, atom, atomObj.Timeout, func(ctx context.Context) ([]any, error) {
+		return withTimeout(ctx, atom+"."+action, actionObj.GetBaseAction().Timeout, run)
+	})
+}
+
+// actionContext runs the action with its prepares and nextpages
+func (m *Molecule) actionContext(topRecursive bool, ctx context.Context, db *sql.DB, atom, action string, atomObj *Atom, actionObj Capability, args, extra, globalArgs, globalExtra map[string]any, sel []string) ([]any, error) {
+	tableObj := atomObj.Table
 	prepares := actionObj.GetBaseAction().Prepares
 	nextpages := actionObj.GetBaseAction().Nextpages
 
@@ -159,34 +276,26 @@ func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.D
 		isDo := pAtom.GetAction(p.ActionName).GetBaseAction().IsDo
 		isRecursive := pTable.IsRecursive()
 
-		var lists []any
-		var err error
 		if topRecursive {
 			if !hasValue(preArgs) {
 				return []any{args}, nil
 			}
-			pk := pTable.Pks[0]
 			if isRecursive {
-				switch t := preArgs.(type) {
-				case map[string]any:
-					delete(t, pk)
-				case []any:
-					for _, s := range t {
-						delete(s.(map[string]any), pk)
-					}
-				case []map[string]any:
-					for _, s := range t {
-						delete(s, pk)
-					}
-				}
+				deleteKeys(preArgs, pTable.Pks)
 			}
-			lists, err = m.runRecurseContext(ctx, db, p.AtomName, p.ActionName, &RunOption{Args: preArgs, Extra: preExtra, GlobalArgs: globalArgs, GlobalExtra: globalExtra})
-		} else if isDo && isRecursive {
-			// this triggers the original topRecursive and is always a DO action
-			lists, err = m.runRecurseContext(ctx, db, p.AtomName, p.ActionName, &RunOption{Args: preArgs, Extra: preExtra, GlobalArgs: globalArgs, GlobalExtra: globalExtra})
-		} else if m.PreStopper == nil || !m.PreStopper.Stop(&tableObj, &pTable) {
-			lists, err = m.RunContext(ctx, db, p.AtomName, p.ActionName, &RunOption{Args: preArgs, Extra: preExtra, GlobalArgs: globalArgs, GlobalExtra: globalExtra})
-		} // else means stopper is set and stopper stops preparing insert or update
+		}
+		lists, err := withTimeout(ctx, p.AtomName+"."+p.ActionName, p.Timeout, func(ctx context.Context) ([]any, error) {
+			opt := &RunOption{Args: preArgs, Extra: preExtra, GlobalArgs: globalArgs, GlobalExtra: globalExtra}
+			if topRecursive {
+				return m.runRecurseContext(ctx, db, p.AtomName, p.ActionName, opt)
+			} else if isDo && isRecursive {
+				// this triggers the original topRecursive and is always a DO action
+				return m.runRecurseContext(ctx, db, p.AtomName, p.ActionName, opt)
+			} else if m.PreStopper == nil || !m.PreStopper.Stop(&tableObj, &pTable) {
+				return m.processContext(false, ctx, db, p.AtomName, p.ActionName, opt)
+			} // else means stopper is set and stopper stops preparing insert or update
+			return nil, nil
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -202,7 +311,7 @@ func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.D
 				newExtra = mergeMap(newExtra, p.nextExtra(item))
 			}
 			newArgs = tmp
-			if p.ActionName == "delecs" {
+			if _, ok := pAtom.GetAction(p.ActionName).(*Delecs); ok {
 				continue
 			} else if isDo {
 				break
@@ -213,19 +322,7 @@ func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.D
 		if hasValue(lists) && hasValue(lists[0]) {
 			middle := p.nextArgs(lists[0])
 			if topRecursive && isRecursive {
-				pk := pTable.Pks[0]
-				switch t := middle.(type) {
-				case map[string]any:
-					delete(t, pk)
-				case []any:
-					for _, s := range t {
-						delete(s.(map[string]any), pk)
-					}
-				case []map[string]any:
-					for _, s := range t {
-						delete(s, pk)
-					}
-				}
+				deleteKeys(middle, pTable.Pks)
 			}
 			newArgs = mergeArgs(newArgs, middle.(map[string]any), true)
 			newExtra = mergeMap(newExtra, p.nextExtra(lists[0]))
@@ -237,22 +334,69 @@ func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.D
 		newArgs = tableObj.refreshArgs(newArgs)
 	}
 
-	data, err := atomObj.RunAtomContext(ctx, db, action, newArgs, newExtra)
+	// the selected fields are read by Topics and Edit
+	fields, err := selectFields(sel, atom, &tableObj, nextpages)
 	if err != nil {
 		return nil, err
 	}
+	var fieldsName string
+	if x, ok := actionObj.(interface{ setDefaultElementNames() []string }); ok && fields != nil {
+		fieldsName = x.setDefaultElementNames()[0]
+		newArgs = withFields(newArgs, fieldsName, strings.Join(fields, ","))
+	}
 
-	if actionObject, ok := actionObj.(*Topics); ok {
-		switch t := newArgs.(type) {
-		case map[string]any:
-			for _, item := range []string{actionObject.FIELDS, actionObject.SORTBY, actionObject.SORTREVERSE, actionObject.PAGESIZE, actionObject.PAGENO, actionObject.TOTALNO, actionObject.MAXPAGENO} {
-				if _, ok := t[item]; ok {
-					if _, ok := args[item]; !ok {
-						args[item] = t[item]
-					}
+	// Topics filters by conditions on nextpages in extra
+	queryExtra := newExtra
+	if _, ok := actionObj.(*Topics); ok {
+		if queryExtra, err = m.existsExtra(atom, &tableObj, tableObj.QualifiedName(), "sub", nextpages, newExtra); err != nil {
+			return nil, err
+		}
+	}
+
+	key, ttl := m.resultKey(ctx, atom, action, actionObj, newArgs, queryExtra)
+	state := runStateFrom(ctx)
+	isDo := actionObj.GetBaseAction().IsDo
+	data, hit := m.getResult(key, newArgs)
+	if !hit {
+		if ok, err := state.query(isDo); !ok {
+			return nil, err
+		}
+		// Topics reports its pagination, which is kept in the copy of args
+		runCtx := ctx
+		paging := make(map[string]any)
+		if _, ok := actionObj.(*Topics); ok {
+			runCtx = withPaging(ctx, paging)
+		}
+		// the hooks run around the whole action, not for each row
+		data, err = atomObj.runAtomContext(runCtx, db, action, newArgs, nil, queryExtra)
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := newArgs.(map[string]any); ok {
+			for k, v := range paging {
+				t[k] = v
+			}
+		}
+		if actionObj.GetBaseAction().IsDo {
+			m.invalidate(ctx, atom)
+		}
+		m.setResult(atom, key, ttl, actionObj, newArgs, data)
+	}
+	if data, err = state.rows(isDo, data); err != nil {
+		return nil, err
+	}
+
+	// the pagination of the called Topics is reported in the statistics,
+	// leaving the caller's args unchanged
+	if actionObject, ok := actionObj.(*Topics); ok && state != nil && levelDepth(ctx) == 1 {
+		if t, ok := newArgs.(map[string]any); ok {
+			paging := make(map[string]any)
+			for _, item := range []string{actionObject.PAGESIZE, actionObject.PAGENO, actionObject.TOTALNO, actionObject.MAXPAGENO} {
+				if v, ok := t[item]; ok {
+					paging[item] = v
 				}
 			}
-		default:
+			state.stats.Paging = paging
 		}
 	}
 
@@ -269,7 +413,7 @@ func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.D
 		}
 	}
 
-	if topRecursive && action != "delecs" {
+	if _, isDelecs := actionObj.(*Delecs); topRecursive && !isDelecs {
 		if tableObj.IsRecursive() {
 			var p *Connection
 			var pAtom *Atom
@@ -294,7 +438,9 @@ func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.D
 			for _, item := range data {
 				nextArgs := p.nextArgs(item)
 				nextArgs = mergeArgs(argsData, nextArgs)
-				_, err = m.runRecurseContext(ctx, db, p.AtomName, p.ActionName, &RunOption{Args: nextArgs, GlobalArgs: globalArgs, GlobalExtra: globalExtra})
+				_, err = withTimeout(ctx, p.AtomName+"."+p.ActionName, p.Timeout, func(ctx context.Context) ([]any, error) {
+					return m.runRecurseContext(ctx, db, p.AtomName, p.ActionName, &RunOption{Args: nextArgs, GlobalArgs: globalArgs, GlobalExtra: globalExtra})
+				})
 				if err != nil {
 					return nil, err
 				}
@@ -312,6 +458,20 @@ func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.D
 		if m.Stopper != nil && m.Stopper.Stop(&tableObj, &(pAtom.Table)) {
 			continue
 		}
+		// the selection expands only read nextpages
+		nextSel, selected := selectNext(sel, p.Subname())
+		if !selected && !pAction.GetBaseAction().IsDo {
+			continue
+		}
+		if p.WithRecursive {
+			ok, err := m.readRecursiveContext(ctx, db, atom, &tableObj, p, data, nextSel, globalArgs, globalExtra)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+		}
 
 		for _, item := range data {
 			if item == nil {
@@ -333,7 +493,9 @@ func (m *Molecule) execContext(topRecursive bool, ctx context.Context, db *sql.D
 					continue
 				}
 			}
-			newLists, err := m.RunContext(ctx, db, p.AtomName, p.ActionName, &RunOption{Args: nextArgs, Extra: nextExtra, GlobalArgs: globalArgs, GlobalExtra: globalExtra})
+			newLists, err := withTimeout(ctx, p.AtomName+"."+p.ActionName, p.Timeout, func(ctx context.Context) ([]any, error) {
+				return m.processContext(false, ctx, db, p.AtomName, p.ActionName, &RunOption{Args: nextArgs, Extra: nextExtra, GlobalArgs: globalArgs, GlobalExtra: globalExtra, Select: nextSel})
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -1,9 +1,12 @@
 package godbi
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/genelet/horizon/dethcl"
@@ -286,3 +289,131 @@ func TestMoleculeThreeTables2(t *testing.T) {
 	}
 	MoleculeThreeGeneral(molecule, t)
 }
+
+func TestMoleculeCompile(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`,
+		`CREATE TABLE m_b (tid integer primary key autoincrement, id int, y varchar(8))`,
+		`INSERT INTO m_a (x) VALUES ('a'), ('b')`,
+		`INSERT INTO m_b (id, y) VALUES (1, 'c'), (1, 'd'), (2, 'e')`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	molecule := &Molecule{
+		Atoms: []*Atom{{
+			AtomName: "m_a",
+			Table: Table{TableName: "m_a", Pks: []string{"id"}, IDAuto: "id", Columns: []*Col{
+				{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+				{ColumnName: "x", Label: "x", TypeName: "string", Notnull: true}}},
+			Actions: []Capability{&Topics{Action: Action{ActionName: "topics", Nextpages: []*Connection{
+				{AtomName: "m_b", ActionName: "topics", RelateExtra: map[string]string{"id": "id"}, Marker: "m_b"}}}}},
+		}, {
+			AtomName: "m_b",
+			Table: Table{TableName: "m_b", Pks: []string{"tid"}, IDAuto: "tid", Columns: []*Col{
+				{ColumnName: "tid", Label: "tid", TypeName: "int", Auto: true},
+				{ColumnName: "id", Label: "id", TypeName: "int"},
+				{ColumnName: "y", Label: "y", TypeName: "string"}}},
+			Actions: []Capability{&Topics{Action: Action{ActionName: "topics"}}},
+		}},
+		DBDriver: SQLite,
+	}
+
+	compiled, err := molecule.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if molecule.Atoms[0].GetDialect().Name() != "" || molecule.Atoms[0].GetAction("topics").(*Topics).FIELDS != "" {
+		t.Errorf("source molecule changed")
+	}
+	if compiled.GetAtom("m_a").GetAction("topics").(*Topics).FIELDS != "fields" {
+		t.Errorf("defaults not set")
+	}
+
+	// the args are shared by the runs, which read but do not change them
+	shared := map[string]any{"sortby": "x", "pagesize": 10}
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats := new(RunStats)
+			lists, err := compiled.RunContext(context.Background(), db, "m_a", "topics", &RunOption{Args: shared, Stats: stats})
+			if err == nil && (len(lists) != 2 || len(lists[0].(map[string]any)["m_b"].([]any)) != 2) {
+				err = fmt.Errorf("%#v", lists)
+			}
+			if err == nil && (stats.Paging["pageno"] != 1 || stats.Paging["pagesize"] != 10) {
+				err = fmt.Errorf("%#v", stats.Paging)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(shared) != 2 {
+		t.Errorf("%#v", shared)
+	}
+
+	molecule.Atoms[0].Actions[0].GetBaseAction().Nextpages[0].ActionName = "edit"
+	molecule.Atoms[0].Table.Columns[1].ColumnName = "z"
+	if _, err = molecule.Compile(); err == nil {
+		t.Errorf("unknown action not reported")
+	}
+	// the compiled copy keeps its own connections and columns
+	compiledA := compiled.GetAtom("m_a")
+	if compiledA.GetAction("topics").GetBaseAction().Nextpages[0].ActionName != "topics" || compiledA.Table.Columns[1].ColumnName != "x" {
+		t.Errorf("compiled molecule changed")
+	}
+}
+
+func TestMoleculeHCLRoundTrip(t *testing.T) {
+	m := new(Molecule)
+	err := json.Unmarshal([]byte(`{"dbDriver":1,"dialect":"upper","atoms":[{"atomName":"m_a","tableName":"m_a","pks":["id"],"columns":[{"columnName":"id","typeName":"int","label":"id"},{"columnName":"x","typeName":"string","label":"x"}],"actions":[{"actionName":"count","column":"x"},{"actionName":"listX","actionType":"topics","picked":["x"],"nextpages":[{"atomName":"m_a","actionName":"edit","relateExtra":{"id":"id"}}]},{"actionName":"stmt","statement":"SELECT 1"}]}]}`), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a named instance built in Go, without ActionType
+	m.Atoms[0].Actions = append(m.Atoms[0].Actions, &Edit{Action: Action{ActionName: "one", Picked: []string{"id"}}})
+
+	expected, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := m.MarshalHCL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := new(Molecule)
+	if err = m2.UnmarshalHCL(bs); err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(m2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(expected) {
+		t.Errorf("%s\n%s", got, expected)
+	}
+	if _, ok := m2.Atoms[0].GetAction("one").(*Edit); !ok || m.Atoms[0].GetAction("one").GetBaseAction().ActionType != "" {
+		t.Errorf("%#v", m2.Atoms[0].Actions)
+	}
+	if _, ok := m2.Atoms[0].GetAction("count").(*counter); !ok {
+		t.Errorf("%#v", m2.Atoms[0].Actions)
+	}
+}
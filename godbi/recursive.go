@@ -0,0 +1,252 @@
+package godbi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// recursiveRelation pairs the key columns of a recursive table with the
+// parent columns referencing them, as related by a nextpage
+type recursiveRelation struct {
+	keyLabels    []string
+	keys         []string
+	parentLabels []string
+	parents      []string
+}
+
+// readRecursiveContext reads the subtrees under data of the recursive
+// table in one query, for nextpage p of the table to itself. It nests them
+// as the nextpage read node by node would. It returns false if p can not be
+// read so, e.g. the nextpage has other nextpages, hooks or a selection.
+func (m *Molecule) readRecursiveContext(ctx context.Context, db *sql.DB, atom string, tableObj *Table, p *Connection, data []any, sel []string, globalArgs, globalExtra map[string]any) (bool, error) {
+	if p.AtomName != atom || !tableObj.IsRecursive() || len(sel) > 0 || m.Stopper != nil {
+		return false, nil
+	}
+	if hasValue(globalArgs[atom]) || hasValue(globalExtra[atom]) {
+		return false, nil
+	}
+	atomObj := m.GetAtom(atom)
+	topics, ok := atomObj.GetAction(p.ActionName).(*Topics)
+	if !ok || atomObj.hooksOf(p.ActionName) != nil || !p.isRecursiveOf(topics) {
+		return false, nil
+	}
+	relation := tableObj.recursiveRelation(p.RelateExtra)
+	if relation == nil {
+		return false, nil
+	}
+	columns, labels := tableObj.recursiveColumns(topics.getAllowed(), relation)
+	if columns == nil {
+		return false, nil
+	}
+
+	var seeds [][]any
+	for _, item := range data {
+		if hash, ok := item.(map[string]any); ok {
+			if values := pickValues(hash, relation.keyLabels); values != nil {
+				seeds = append(seeds, values)
+			}
+		}
+	}
+	if seeds == nil {
+		return true, nil
+	}
+
+	step := p.AtomName + "." + p.ActionName
+	state := runStateFrom(ctx)
+	if ok, err := state.query(false); !ok {
+		return true, withPath(err, step)
+	}
+	str, values := tableObj.subtreeSQL(relation, columns, seeds)
+	rows, err := withTimeout(ctx, step, p.Timeout, func(ctx context.Context) ([]any, error) {
+		return getSQL(ctx, tableObj.dbi(db), str, labels, values...)
+	})
+	if err == nil {
+		rows, err = state.rows(false, rows)
+	}
+	if err != nil {
+		return true, withPath(err, step)
+	}
+
+	children := make(map[string][]map[string]any)
+	for _, row := range rows {
+		hash := row.(map[string]any)
+		key := fmt.Sprint(pickValues(hash, relation.parentLabels))
+		children[key] = append(children[key], hash)
+	}
+
+	var attach func(item map[string]any, depth int, path map[string]bool) error
+	attach = func(item map[string]any, depth int, path map[string]bool) error {
+		values := pickValues(item, relation.keyLabels)
+		kids := children[fmt.Sprint(values)]
+		if values == nil || len(kids) == 0 {
+			return nil
+		}
+		if ok, err := state.descend(depth); !ok {
+			return err
+		}
+		var lists []any
+		for _, kid := range kids {
+			key := fmt.Sprint(pickValues(kid, relation.keyLabels))
+			if path[key] {
+				// the node leads back to itself, where the reads node by node would stop
+				if !state.truncate(false) {
+					return errorCycle(step)
+				}
+				lists = append(lists, cloneMap(kid))
+				continue
+			}
+			path[key] = true
+			err := attach(kid, depth+1, path)
+			delete(path, key)
+			if err != nil {
+				return err
+			}
+			lists = append(lists, kid)
+		}
+		item[p.Subname()] = p.shortenRecursive(lists)
+		return nil
+	}
+
+	depth := levelDepth(ctx) + 1
+	for _, item := range data {
+		hash, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		path := map[string]bool{fmt.Sprint(pickValues(hash, relation.keyLabels)): true}
+		if err := attach(hash, depth, path); err != nil {
+			return true, withPath(err, step)
+		}
+	}
+	return true, nil
+}
+
+// isRecursiveOf tells if c is the only connection of action, which reads
+// the table recursively along c
+func (c *Connection) isRecursiveOf(action *Topics) bool {
+	if len(action.Prepares) > 0 || len(action.Nextpages) != 1 || len(c.RelateArgs) > 0 {
+		return false
+	}
+	next := action.Nextpages[0]
+	return next.AtomName == c.AtomName && next.ActionName == c.ActionName &&
+		next.Marker == c.Marker && next.Dimension == c.Dimension &&
+		len(next.RelateArgs) == 0 && reflect.DeepEqual(next.RelateExtra, c.RelateExtra)
+}
+
+// recursiveRelation returns the relation of the table to itself by relate,
+// which maps labels of the keys to the parent columns, or nil if relate
+// does not relate all keys to recursive columns
+func (t *Table) recursiveRelation(relate map[string]string) *recursiveRelation {
+	if _, ok := relate["ALL"]; ok || len(relate) != len(t.Pks) {
+		return nil
+	}
+	relation := new(recursiveRelation)
+	labels := make([]string, 0, len(relate))
+	for label := range relate {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		var key, parent *Col
+		for _, col := range t.Columns {
+			if col.Label == label {
+				key = col
+			}
+			if col.ColumnName == relate[label] {
+				parent = col
+			}
+		}
+		if key == nil || parent == nil || !grep(t.Pks, key.ColumnName) || !parent.Recurse {
+			return nil
+		}
+		relation.keyLabels = append(relation.keyLabels, key.Label)
+		relation.keys = append(relation.keys, key.ColumnName)
+		relation.parentLabels = append(relation.parentLabels, parent.Label)
+		relation.parents = append(relation.parents, parent.ColumnName)
+	}
+	return relation
+}
+
+// recursiveColumns returns the columns and labels read by the recursive
+// query, or nil if allowed misses the columns of the relation
+func (t *Table) recursiveColumns(allowed map[string]bool, relation *recursiveRelation) ([]string, []any) {
+	var columns []string
+	var labels []any
+	for _, col := range t.Columns {
+		if allowed == nil || allowed[col.Label] {
+			columns = append(columns, col.ColumnName)
+			labels = append(labels, [2]string{col.Label, col.TypeName})
+		}
+	}
+	for _, names := range [][]string{relation.keys, relation.parents} {
+		for _, column := range names {
+			if !grep(columns, column) {
+				return nil, nil
+			}
+		}
+	}
+	return columns, labels
+}
+
+// subtreeSQL returns the query reading all rows under the rows of keys
+// seeds, ordered by the primary key as Topics does
+func (t *Table) subtreeSQL(relation *recursiveRelation, columns []string, seeds [][]any) (string, []any) {
+	with, union := "WITH RECURSIVE", "UNION"
+	if x, ok := t.GetDialect().(Recurser); ok {
+		with, union = x.Recursive()
+	}
+
+	var where []string
+	var values []any
+	if len(relation.parents) == 1 {
+		field := t.quote(relation.parents[0])
+		marks := strings.TrimSuffix(strings.Repeat("?,", len(seeds)), ",")
+		where = append(where, field+" IN ("+marks+")")
+		for _, seed := range seeds {
+			values = append(values, seed[0])
+		}
+	} else {
+		for _, seed := range seeds {
+			var ands []string
+			for i, parent := range relation.parents {
+				ands = append(ands, t.quote(parent)+" =?")
+				values = append(values, seed[i])
+			}
+			where = append(where, "("+strings.Join(ands, " AND ")+")")
+		}
+	}
+
+	subtree := t.quote(t.TableName + "_subtree")
+	var children, joins []string
+	for _, column := range columns {
+		children = append(children, t.quote("child."+column))
+	}
+	for i, parent := range relation.parents {
+		joins = append(joins, t.quote("child."+parent)+" = "+subtree+"."+t.quote(relation.keys[i]))
+	}
+
+	str := with + " " + subtree + " (" + t.quoteList(columns) + ") AS (\n" +
+		"SELECT " + t.quoteList(columns) + "\nFROM " + t.quotedName() + "\nWHERE " + strings.Join(where, " OR ") +
+		"\n" + union + "\n" +
+		"SELECT " + strings.Join(children, ", ") + "\nFROM " + t.quotedName() + " " + t.quote("child") +
+		"\nINNER JOIN " + subtree + " ON " + strings.Join(joins, " AND ") + "\n)\n" +
+		"SELECT " + t.quoteList(columns) + "\nFROM " + subtree + "\nORDER BY " + t.quoteList(t.Pks)
+	return t.GetDialect().Placeholder(str), values
+}
+
+// pickValues returns the values of labels in item, or nil if one is missing
+func pickValues(item map[string]any, labels []string) []any {
+	var values []any
+	for _, label := range labels {
+		v, ok := item[label]
+		if !ok || v == nil {
+			return nil
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,99 @@
+package godbi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWithRecursive(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE tree (id integer primary key, parent_id int, name varchar(8))`,
+		// 1 > (2 > 4 > 5), (3 > 6, 7), and 8 > 9 > 8
+		`INSERT INTO tree VALUES (1, NULL, 'root'), (2, 1, 'a'), (3, 1, 'b'), (4, 2, 'a1'), (5, 4, 'a11'), (6, 3, 'b1'), (7, 3, 'b2'), (8, 9, 'x'), (9, 8, 'y')`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	str := `{"dbDriver":2,"atoms":[
+{"atomName":"tree","tableName":"tree","pks":["id"],"columns":[{"columnName":"id","typeName":"int","label":"id","recurse":true},{"columnName":"parent_id","typeName":"int","label":"parent_id","recurse":true},{"columnName":"name","typeName":"string","label":"name"}],
+ "actions":[
+	{"actionName":"topics","nextpages":[{"atomName":"tree","actionName":"topics","relateExtra":{"id":"parent_id"},"marker":"children"}]},
+	{"actionName":"edit","nextpages":[{"atomName":"tree","actionName":"topics","relateExtra":{"id":"parent_id"},"marker":"children","withRecursive":true}]}]}]}`
+	byNode := new(Molecule)
+	if err = json.Unmarshal([]byte(strings.ReplaceAll(str, `,"withRecursive":true`, "")), byNode); err != nil {
+		t.Fatal(err)
+	}
+	molecule := new(Molecule)
+	if err = json.Unmarshal([]byte(str), molecule); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	for _, id := range []int{1, 3, 5} {
+		opt := &RunOption{Args: map[string]any{"id": id}, Stats: new(RunStats)}
+		expected, err := byNode.RunContext(ctx, db, "tree", "edit", opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stats := new(RunStats)
+		lists, err := molecule.RunContext(ctx, db, "tree", "edit", &RunOption{Args: map[string]any{"id": id}, Stats: stats})
+		if err != nil || !reflect.DeepEqual(lists, expected) {
+			t.Errorf("%d: %v %#v %#v", id, err, lists, expected)
+		}
+		// one for the edit, and one for the subtree
+		if stats.Queries != 2 || stats.Rows != opt.Stats.Rows || (id == 1 && opt.Stats.Queries != 8) {
+			t.Errorf("%d: %#v %#v", id, stats, opt.Stats)
+		}
+	}
+
+	_, err = molecule.RunContext(ctx, db, "tree", "edit", &RunOption{Args: map[string]any{"id": 8}})
+	if !errors.Is(err, ErrCycle) {
+		t.Errorf("%v", err)
+	}
+	stats := new(RunStats)
+	lists, err := molecule.RunContext(ctx, db, "tree", "edit", &RunOption{Args: map[string]any{"id": 8}, Limits: &Limits{Truncate: true}, Stats: stats})
+	if err != nil || len(lists) != 1 || !stats.Truncated {
+		t.Fatalf("%v %#v %#v", err, lists, stats)
+	}
+	if y := lists[0].(map[string]any)["children"].([]any)[0].(map[string]any); y["name"] != "x" {
+		t.Errorf("%#v", lists)
+	}
+
+	_, err = molecule.RunContext(ctx, db, "tree", "edit", &RunOption{Args: map[string]any{"id": 1}, Limits: &Limits{MaxDepth: 2}})
+	if !errors.Is(err, ErrLimit) {
+		t.Errorf("%v", err)
+	}
+
+	table := &molecule.GetAtom("tree").Table
+	relation := table.recursiveRelation(map[string]string{"id": "parent_id"})
+	for _, driver := range []DBType{Postgres, SQLServer} {
+		table.SetDialect(driver.Dialect())
+		sql, values := table.subtreeSQL(relation, []string{"id", "parent_id"}, [][]any{{1}, {2}})
+		if len(values) != 2 || !strings.Contains(sql, "ORDER BY") {
+			t.Errorf("%s %v", sql, values)
+		}
+		switch driver {
+		case Postgres:
+			if !strings.HasPrefix(sql, `WITH RECURSIVE "tree_subtree"`) || !strings.Contains(sql, `"parent_id" IN ($1,$2)`) || !strings.Contains(sql, "\nUNION\n") {
+				t.Errorf("%s", sql)
+			}
+		default:
+			if !strings.HasPrefix(sql, "WITH [tree_subtree]") || !strings.Contains(sql, "\nUNION ALL\n") {
+				t.Errorf("%s", sql)
+			}
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package godbi
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// ResultCache caches the results of read actions, Edit and Topics, by atom.
+// The value is opaque to the cache, which must not change it.
+// The results of an atom are invalidated when a do-action runs on it.
+type ResultCache interface {
+	// Get returns the unexpired value by key
+	Get(key string) (any, bool)
+	// Set saves the value of atom by key for duration ttl
+	Set(atom, key string, value any, ttl time.Duration)
+	// Invalidate removes all values of atom
+	Invalidate(atom string)
+}
+
+// cachedResult is the value saved in ResultCache: the output of
+// the action, and the pagination arguments set by Topics
+type cachedResult struct {
+	data   []any
+	paging map[string]any
+}
+
+// copyResult copies the rows, so they can be changed by nextpages
+func copyResult(data []any) []any {
+	if data == nil {
+		return nil
+	}
+	lists := make([]any, len(data))
+	for i, item := range data {
+		if hash, ok := item.(map[string]any); ok {
+			item = cloneMap(hash)
+		}
+		lists[i] = item
+	}
+	return lists
+}
+
+// resultKey returns the cache key and TTL of action on atom by args and
+// extra, or an empty key if the result is not cached. Only Edit and Topics
+// are cached, and never inside a transaction. Nor are the results filtered
+// by the rows of nextpages, which the do-actions on those atoms change.
+func (m *Molecule) resultKey(ctx context.Context, atom, action string, actionObj Capability, args any, extra map[string]any) (string, time.Duration) {
+	if m.results == nil || m.ttls[atom] <= 0 || TxFromContext(ctx) != nil {
+		return "", 0
+	}
+	switch actionObj.(type) {
+	case *Edit, *Topics:
+	default:
+		return "", 0
+	}
+	for _, v := range extra {
+		if _, ok := v.(existsCondition); ok {
+			return "", 0
+		}
+	}
+	bs, err := json.Marshal([]any{atom, action, args, extra})
+	if err != nil {
+		return "", 0
+	}
+	return string(bs), m.ttls[atom]
+}
+
+// getResult returns the cached rows by key, and sets the cached
+// pagination in args
+func (m *Molecule) getResult(key string, args any) ([]any, bool) {
+	if key == "" {
+		return nil, false
+	}
+	v, ok := m.results.Get(key)
+	if !ok {
+		return nil, false
+	}
+	cached := v.(*cachedResult)
+	if t, ok := args.(map[string]any); ok {
+		for k, v := range cached.paging {
+			t[k] = v
+		}
+	}
+	return copyResult(cached.data), true
+}
+
+// setResult caches the rows by key, with the pagination Topics sets in args
+func (m *Molecule) setResult(atom, key string, ttl time.Duration, actionObj Capability, args any, data []any) {
+	if key == "" {
+		return
+	}
+	cached := &cachedResult{data: copyResult(data)}
+	t, ok := args.(map[string]any)
+	if topics, isTopics := actionObj.(*Topics); ok && isTopics {
+		cached.paging = make(map[string]any)
+		for _, item := range []string{topics.PAGENO, topics.TOTALNO, topics.MAXPAGENO} {
+			if v, ok := t[item]; ok {
+				cached.paging[item] = v
+			}
+		}
+	}
+	m.results.Set(atom, key, cached, ttl)
+}
+
+// pendingAtoms are the atoms changed in a transaction of RunTxContext,
+// whose results are invalidated after the commit
+type pendingAtoms map[string]bool
+
+type pendingAtomsKey struct{}
+
+// withPendingAtoms returns ctx collecting the changed atoms
+func withPendingAtoms(ctx context.Context) (context.Context, pendingAtoms) {
+	pending := make(pendingAtoms)
+	return context.WithValue(ctx, pendingAtomsKey{}, pending), pending
+}
+
+// invalidate invalidates the results of atom. In a transaction of
+// RunTxContext, it is done after the commit, so that readers outside the
+// transaction can not cache the rows before it. In a transaction of the
+// caller, it is done at once.
+func (m *Molecule) invalidate(ctx context.Context, atom string) {
+	if m.results == nil {
+		return
+	}
+	if TxFromContext(ctx) != nil {
+		if pending, ok := ctx.Value(pendingAtomsKey{}).(pendingAtoms); ok {
+			pending[atom] = true
+			return
+		}
+	}
+	m.results.Invalidate(atom)
+}
+
+// invalidatePending invalidates the results of the atoms changed in the
+// committed transaction
+func (m *Molecule) invalidatePending(pending pendingAtoms) {
+	if m.results == nil {
+		return
+	}
+	for atom := range pending {
+		m.results.Invalidate(atom)
+	}
+}
+
+// MemoryCache is the in-memory ResultCache, safe for concurrent use.
+// It holds at most a number of values, evicting the least recently used
+// one when full. Expired values are removed when they are read, their atom
+// invalidated or they are the least recently used.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	items      map[string]*list.Element
+	order      *list.List
+	atoms      map[string]map[string]bool
+}
+
+type memoryItem struct {
+	key     string
+	atom    string
+	value   any
+	expires time.Time
+}
+
+// DefaultMemoryCacheEntries is the number of values held by MemoryCache
+// if not given
+const DefaultMemoryCacheEntries = 10000
+
+// NewMemoryCache returns an empty in-memory cache holding at most
+// maxEntries values, or DefaultMemoryCacheEntries if maxEntries is not
+// positive
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMemoryCacheEntries
+	}
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		items:      make(map[string]*list.Element),
+		order:      list.New(),
+		atoms:      make(map[string]map[string]bool),
+	}
+}
+
+// Get returns the unexpired value by key
+func (c *MemoryCache) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	item := elem.Value.(*memoryItem)
+	if time.Now().After(item.expires) {
+		c.remove(elem)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return item.value, true
+}
+
+// Set saves the value of atom by key for duration ttl
+func (c *MemoryCache) Set(atom, key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		c.remove(elem)
+	}
+	for c.order.Len() >= c.maxEntries {
+		c.remove(c.order.Back())
+	}
+	c.items[key] = c.order.PushFront(&memoryItem{key: key, atom: atom, value: value, expires: time.Now().Add(ttl)})
+	if c.atoms[atom] == nil {
+		c.atoms[atom] = make(map[string]bool)
+	}
+	c.atoms[atom][key] = true
+}
+
+// Invalidate removes all values of atom
+func (c *MemoryCache) Invalidate(atom string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.atoms[atom] {
+		if elem, ok := c.items[key]; ok {
+			c.order.Remove(elem)
+			delete(c.items, key)
+		}
+	}
+	delete(c.atoms, atom)
+}
+
+// Len returns the number of values in the cache
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+func (c *MemoryCache) remove(elem *list.Element) {
+	item := c.order.Remove(elem).(*memoryItem)
+	delete(c.items, item.key)
+	if keys := c.atoms[item.atom]; keys != nil {
+		delete(keys, item.key)
+		if len(keys) == 0 {
+			delete(c.atoms, item.atom)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package godbi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestResultCache(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`,
+		`CREATE TABLE m_b (tid integer primary key autoincrement, id int, y varchar(8))`,
+		`INSERT INTO m_a (x) VALUES ('a'), ('b')`,
+		`INSERT INTO m_b (id, y) VALUES (1, 'c'), (1, 'd'), (2, 'e')`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	molecule := &Molecule{
+		Atoms: []*Atom{{
+			AtomName: "m_a",
+			Table: Table{TableName: "m_a", Pks: []string{"id"}, IDAuto: "id", Columns: []*Col{
+				{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+				{ColumnName: "x", Label: "x", TypeName: "string", Notnull: true}}},
+			Actions: []Capability{&Topics{Action: Action{ActionName: "topics", Nextpages: []*Connection{
+				{AtomName: "m_b", ActionName: "topics", RelateExtra: map[string]string{"id": "id"}, Marker: "m_b"}}}}},
+		}, {
+			AtomName: "m_b",
+			Table: Table{TableName: "m_b", Pks: []string{"tid"}, IDAuto: "tid", Columns: []*Col{
+				{ColumnName: "tid", Label: "tid", TypeName: "int", Auto: true},
+				{ColumnName: "id", Label: "id", TypeName: "int"},
+				{ColumnName: "y", Label: "y", TypeName: "string"}}},
+			Actions: []Capability{
+				&Topics{Action: Action{ActionName: "topics"}},
+				&Insert{Action: Action{ActionName: "insert", IsDo: true}}},
+		}},
+		DBDriver: SQLite,
+	}
+	cache := NewMemoryCache(0)
+	molecule.SetResultCache(cache, map[string]time.Duration{"m_b": time.Minute})
+
+	ctx := context.Background()
+	count := func() int {
+		lists, err := molecule.RunContext(ctx, db, "m_a", "topics", &RunOption{Args: map[string]any{"sortby": "id"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(lists[0].(map[string]any)["m_b"].([]any))
+	}
+
+	if n := count(); n != 2 || cache.Len() != 2 {
+		t.Errorf("%d rows, %d cached", n, cache.Len())
+	}
+	// changes outside of the molecule are not seen until invalidated
+	if _, err = db.Exec(`INSERT INTO m_b (id, y) VALUES (1, 'f')`); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(); n != 2 {
+		t.Errorf("%d rows from cache", n)
+	}
+
+	_, err = molecule.RunContext(ctx, db, "m_b", "insert", &RunOption{Args: map[string]any{"id": 1, "y": "g"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("%d cached after insert", cache.Len())
+	}
+	if n := count(); n != 4 {
+		t.Errorf("%d rows after insert", n)
+	}
+
+	// in a transaction, the results are invalidated after the commit
+	var inTx int
+	var fail error
+	molecule.GetAtom("m_b").AddHooks("insert", Hooks{After: func(ctx context.Context, atom, action string, args map[string]any, lists []any) error {
+		inTx = cache.Len()
+		return fail
+	}})
+	_, err = molecule.RunTxContext(ctx, db, "m_b", "insert", &RunOption{Args: map[string]any{"id": 1, "y": "h"}}, nil)
+	if err != nil || inTx != 2 || cache.Len() != 0 {
+		t.Errorf("%v %d cached in transaction, %d after commit", err, inTx, cache.Len())
+	}
+	if n := count(); n != 5 {
+		t.Errorf("%d rows after commit", n)
+	}
+	fail = errors.New("rollback")
+	_, err = molecule.RunTxContext(ctx, db, "m_b", "insert", &RunOption{Args: map[string]any{"id": 1, "y": "i"}}, nil)
+	if err == nil || cache.Len() != 2 {
+		t.Errorf("%v %d cached after rollback", err, cache.Len())
+	}
+
+	cache.Set("m_b", "short", 1, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if _, ok := cache.Get("short"); ok {
+		t.Errorf("expired value found")
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("m_a", "a", 1, time.Minute)
+	cache.Set("m_a", "b", 2, time.Minute)
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("a not found")
+	}
+
+	// b is the least recently used
+	cache.Set("m_b", "c", 3, time.Minute)
+	if _, ok := cache.Get("b"); ok || cache.Len() != 2 {
+		t.Errorf("b found in %d", cache.Len())
+	}
+	if v, ok := cache.Get("c"); !ok || v != 3 {
+		t.Errorf("%v", v)
+	}
+
+	// setting the same key does not evict
+	cache.Set("m_b", "c", 4, time.Minute)
+	if v, ok := cache.Get("a"); !ok || v != 1 || cache.Len() != 2 {
+		t.Errorf("%v %d", v, cache.Len())
+	}
+
+	cache.Invalidate("m_a")
+	if _, ok := cache.Get("a"); ok || cache.Len() != 1 {
+		t.Errorf("a found in %d", cache.Len())
+	}
+
+	cache.Set("m_a", "d", 5, -time.Second)
+	if _, ok := cache.Get("d"); ok || cache.Len() != 1 {
+		t.Errorf("expired d found in %d", cache.Len())
+	}
+
+	for i := 0; i < 100; i++ {
+		cache.Set("m_a", strconv.Itoa(i), i, time.Minute)
+	}
+	if cache.Len() != 2 || len(cache.atoms["m_a"]) != 2 || cache.atoms["m_b"] != nil {
+		t.Errorf("%d %v", cache.Len(), cache.atoms)
+	}
+}
@@ -0,0 +1,192 @@
+package godbi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy retries a transactional run, from its beginning in a new
+// transaction, when it fails by a deadlock or serialization failure.
+type RetryPolicy struct {
+	// MaxAttempts is the number of runs including the first one.
+	// A value less than 2 means no retry.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for each
+	// of the next ones
+	Backoff time.Duration
+	// MaxBackoff caps the delay, if positive
+	MaxBackoff time.Duration
+	// Retryable tells if err is worth retrying. If nil, the dialect
+	// decides by implementing Retrier.
+	Retryable func(err error) bool
+}
+
+// delay returns the backoff before the retry after attempt, from 1
+func (p *RetryPolicy) delay(attempt int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// Retrier is implemented by a dialect recognizing the errors of its driver
+// which are resolved by running the transaction again, e.g. deadlocks and
+// serialization failures. The built-in dialects read SQLSTATE if the error
+// has method SQLState, or else the messages of the drivers.
+type Retrier interface {
+	// Retryable tells if the transaction failed by err should be retried
+	Retryable(err error) bool
+}
+
+// sqlState returns SQLSTATE of the driver error, e.g. of pq and pgx
+func sqlState(err error) string {
+	var x interface{ SQLState() string }
+	if errors.As(err, &x) {
+		return x.SQLState()
+	}
+	return ""
+}
+
+var (
+	reSqliteRetry    = regexp.MustCompile(`database (?:table )?is locked|database is busy|SQLITE_BUSY`)
+	reMysqlRetry     = regexp.MustCompile(`Error 12(?:13|05)\b|Deadlock found when trying to get lock|Lock wait timeout exceeded`)
+	rePostgresRetry  = regexp.MustCompile(`SQLSTATE 40(?:001|P01)|could not serialize access|deadlock detected`)
+	reSqlserverRetry = regexp.MustCompile(`chosen as the deadlock victim|Snapshot isolation transaction aborted due to update conflict`)
+)
+
+func (d sqliteDialect) Retryable(err error) bool {
+	return reSqliteRetry.MatchString(err.Error())
+}
+
+func (d mysqlDialect) Retryable(err error) bool {
+	return reMysqlRetry.MatchString(err.Error())
+}
+
+func (d postgresDialect) Retryable(err error) bool {
+	switch sqlState(err) {
+	case "40001", "40P01":
+		return true
+	case "":
+		return rePostgresRetry.MatchString(err.Error())
+	default:
+	}
+	return false
+}
+
+func (d sqlserverDialect) Retryable(err error) bool {
+	return reSqlserverRetry.MatchString(err.Error())
+}
+
+// SetRetryPolicy sets the policy of retrying RunTxContext
+func (m *Molecule) SetRetryPolicy(policy *RetryPolicy) {
+	m.retry = policy
+}
+
+// retryable tells if the failed transactional run should be retried
+func (m *Molecule) retryable(err error) bool {
+	if m.retry.Retryable != nil {
+		return m.retry.Retryable(err)
+	}
+	if retrier, ok := m.getDialect().(Retrier); ok {
+		return retrier.Retryable(err)
+	}
+	return false
+}
+
+// RunTxContext is the same as RunContext, but runs in a transaction of
+// options txOpts, which is committed if the run succeeds, or else rolled
+// back. If the run or commit fails by a retryable error, it is run again
+// in a new transaction by the retry policy.
+//
+// Each attempt starts from the same Args and GlobalArgs of opt, undoing
+// what the failed attempts wrote into them, e.g. pagination or auto ids.
+//
+// If ctx already carries a transaction, it runs in that one under a
+// savepoint, which is rolled back to if the run fails, so the owner of the
+// transaction may go on. Commit and retry belong to the owner.
+func (m *Molecule) RunTxContext(ctx context.Context, db *sql.DB, atom, action string, opt *RunOption, txOpts *sql.TxOptions) ([]any, error) {
+	if tx := TxFromContext(ctx); tx != nil {
+		return m.runSavepointContext(ctx, tx, db, atom, action, opt)
+	}
+
+	var args, globalArgs any
+	if opt != nil && m.retry != nil && m.retry.MaxAttempts > 1 {
+		args, globalArgs = deepCopy(opt.Args), deepCopy(opt.GlobalArgs)
+	}
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && opt != nil {
+			resetArgs(opt.Args, args)
+			resetArgs(opt.GlobalArgs, globalArgs)
+		}
+		lists, err := m.runTxContext(ctx, db, atom, action, opt, txOpts)
+		if err == nil || m.retry == nil || attempt >= m.retry.MaxAttempts || !m.retryable(err) {
+			return lists, err
+		}
+		delay := m.retry.delay(attempt)
+		if m.logger != nil {
+			m.logger.Warn("godbi.Molecule", "retry", attempt, "delay", delay, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// runTxContext runs once in a new transaction
+func (m *Molecule) runTxContext(ctx context.Context, db *sql.DB, atom, action string, opt *RunOption, txOpts *sql.TxOptions) ([]any, error) {
+	tx, err := db.BeginTx(ctx, txOpts)
+	if err != nil {
+		return nil, err
+	}
+	txCtx, pending := withPendingAtoms(ContextWithTx(ctx, tx))
+	lists, err := m.RunContext(txCtx, db, atom, action, opt)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return nil, errorRollback(err, rollbackErr)
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	m.invalidatePending(pending)
+	return lists, nil
+}
+
+type savepointKey struct{}
+
+// runSavepointContext runs once in tx under a savepoint, named by the depth
+// of the nested runs
+func (m *Molecule) runSavepointContext(ctx context.Context, tx *sql.Tx, db *sql.DB, atom, action string, opt *RunOption) ([]any, error) {
+	depth, _ := ctx.Value(savepointKey{}).(int)
+	depth++
+	name := "godbi_" + strconv.Itoa(depth)
+
+	dialect := m.getDialect()
+	if _, err := tx.ExecContext(ctx, dialect.Savepoint(name)); err != nil {
+		return nil, err
+	}
+	lists, err := m.RunContext(context.WithValue(ctx, savepointKey{}, depth), db, atom, action, opt)
+	if err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, dialect.RollbackSavepoint(name)); rollbackErr != nil {
+			return nil, errorRollback(err, rollbackErr)
+		}
+		return nil, err
+	}
+	if release := dialect.ReleaseSavepoint(name); release != "" {
+		if _, err = tx.ExecContext(ctx, release); err != nil {
+			return nil, err
+		}
+	}
+	return lists, nil
+}
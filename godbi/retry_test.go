@@ -0,0 +1,157 @@
+package godbi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+)
+
+// flaky fails by a busy database until it has run Failures times
+type flaky struct {
+	Action
+	Failures int `json:"failures,omitempty" hcl:"failures,optional"`
+	runs     int
+}
+
+func (f *flaky) RunActionContext(ctx context.Context, db *sql.DB, t *Table, args map[string]any, extra ...map[string]any) ([]any, error) {
+	f.runs++
+	if f.runs <= f.Failures {
+		return nil, errors.New("database is locked")
+	}
+	return []any{args}, nil
+}
+
+func init() {
+	RegisterCapability("flaky", &flaky{})
+}
+
+type sqlStateError string
+
+func (e sqlStateError) Error() string    { return "sql state " + string(e) }
+func (e sqlStateError) SQLState() string { return string(e) }
+
+func TestRetry(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec(`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`); err != nil {
+		t.Fatal(err)
+	}
+
+	action := &flaky{Action: Action{ActionName: "flaky"}}
+	molecule := &Molecule{
+		Atoms: []*Atom{{
+			AtomName: "m_a",
+			Table: Table{TableName: "m_a", Pks: []string{"id"}, IDAuto: "id", Columns: []*Col{
+				{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+				{ColumnName: "x", Label: "x", TypeName: "string"}}},
+			Actions: []Capability{
+				&Insert{Action: Action{ActionName: "insert", IsDo: true, Nextpages: []*Connection{
+					{AtomName: "m_a", ActionName: "flaky", RelateArgs: map[string]string{"id": "id"}}}}},
+				action},
+		}},
+		DBDriver: SQLite,
+	}
+	ctx := context.Background()
+	count := func() int {
+		var n int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM m_a`).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	// no policy, no retry
+	action.Failures = 1
+	if _, err = molecule.RunTxContext(ctx, db, "m_a", "insert", &RunOption{Args: map[string]any{"x": "a"}}, nil); err == nil || action.runs != 1 || count() != 0 {
+		t.Errorf("%v %d", err, action.runs)
+	}
+
+	molecule.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond})
+	action.runs, action.Failures = 0, 2
+	lists, err := molecule.RunTxContext(ctx, db, "m_a", "insert", &RunOption{Args: map[string]any{"x": "a"}}, nil)
+	if err != nil || action.runs != 3 || len(lists) != 1 || count() != 1 {
+		t.Errorf("%v %d %#v", err, action.runs, lists)
+	}
+
+	// out of attempts
+	action.runs, action.Failures = 0, 3
+	if _, err = molecule.RunTxContext(ctx, db, "m_a", "insert", &RunOption{Args: map[string]any{"x": "b"}}, nil); err == nil || action.runs != 3 || count() != 1 {
+		t.Errorf("%v %d", err, action.runs)
+	}
+
+	// each attempt starts from the same input, which the last one writes to
+	molecule.GetAtom("m_a").AddHooks("insert", Hooks{Before: func(ctx context.Context, atom, action string, args, extra map[string]any) error {
+		args["x"] = args["x"].(string) + "!"
+		return nil
+	}})
+	action.runs, action.Failures = 0, 2
+	args := map[string]any{"x": "c", "children": []any{map[string]any{"y": 1}}}
+	lists, err = molecule.RunTxContext(ctx, db, "m_a", "insert", &RunOption{Args: args}, nil)
+	if err != nil || action.runs != 3 || args["x"] != "c!" || len(args["children"].([]any)) != 1 || lists[0].(map[string]any)["x"] != "c!" {
+		t.Errorf("%v %d %#v %#v", err, action.runs, args, lists)
+	}
+	molecule.GetAtom("m_a").hooks = nil
+
+	// not retryable
+	molecule.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, Retryable: func(err error) bool { return false }})
+	action.runs, action.Failures = 0, 1
+	if _, err = molecule.RunTxContext(ctx, db, "m_a", "insert", &RunOption{Args: map[string]any{"x": "b"}}, nil); err == nil || action.runs != 1 || count() != 2 {
+		t.Errorf("%v %d", err, action.runs)
+	}
+
+	// a nested run rolls back to its savepoint, and the transaction goes on
+	molecule.SetRetryPolicy(nil)
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txCtx := ContextWithTx(ctx, tx)
+	action.runs, action.Failures = 0, 0
+	if _, err = molecule.RunTxContext(txCtx, db, "m_a", "insert", &RunOption{Args: map[string]any{"x": "d"}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	action.runs, action.Failures = 0, 1
+	if _, err = molecule.RunTxContext(txCtx, db, "m_a", "insert", &RunOption{Args: map[string]any{"x": "e"}}, nil); err == nil {
+		t.Errorf("%v", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	var x string
+	if err = db.QueryRow(`SELECT x FROM m_a ORDER BY id DESC`).Scan(&x); err != nil || x != "d" || count() != 3 {
+		t.Errorf("%v %s", err, x)
+	}
+
+	policy := &RetryPolicy{Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	for attempt, d := range map[int]time.Duration{1: 10 * time.Millisecond, 2: 20 * time.Millisecond, 3: 40 * time.Millisecond, 4: 50 * time.Millisecond, 9: 50 * time.Millisecond} {
+		if x := policy.delay(attempt); x != d {
+			t.Errorf("%d: %v", attempt, x)
+		}
+	}
+
+	for _, c := range []struct {
+		driver DBType
+		err    error
+		ok     bool
+	}{
+		{Postgres, errors.New("pq: could not serialize access due to concurrent update"), true},
+		{Postgres, errors.New("ERROR: deadlock detected (SQLSTATE 40P01)"), true},
+		{Postgres, sqlStateError("40001"), true},
+		{Postgres, sqlStateError("23505"), false},
+		{MySQL, errors.New("Error 1213 (40001): Deadlock found when trying to get lock; try restarting transaction"), true},
+		{MySQL, errors.New("Error 1062 (23000): Duplicate entry 'a' for key 'm_a.x'"), false},
+		{SQLServer, errors.New("mssql: Transaction (Process ID 52) was deadlocked on lock resources with another process and has been chosen as the deadlock victim. Rerun the transaction."), true},
+		{SQLite, errors.New("database is locked"), true},
+	} {
+		if ok := c.driver.Dialect().(Retrier).Retryable(c.err); ok != c.ok {
+			t.Errorf("%v: %v", c.err, ok)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package godbi
+
+import (
+	"strings"
+)
+
+// selectNext tells if the nextpage of subname is selected by sel, and
+// returns the selection under it. A nil sel selects all nextpages.
+func selectNext(sel []string, subname string) ([]string, bool) {
+	if sel == nil {
+		return nil, true
+	}
+	var sub []string
+	found := false
+	for _, path := range sel {
+		if path == subname {
+			found = true
+		} else if rest, ok := strings.CutPrefix(path, subname+"."); ok {
+			found = true
+			sub = append(sub, rest)
+		}
+	}
+	if found && sub == nil {
+		sub = []string{}
+	}
+	return sub, found
+}
+
+// selectFields returns the fields of the table selected by sel, with the
+// fields needed by the selected nextpages to relate, or nil if sel selects
+// no field. A path which is neither a field nor a nextpage is an error.
+func selectFields(sel []string, atom string, t *Table, nextpages []*Connection) ([]string, error) {
+	labels := make(map[string]bool)
+	for _, col := range t.Columns {
+		labels[col.Label] = true
+	}
+	subnames := make(map[string]*Connection)
+	for _, p := range nextpages {
+		subnames[p.Subname()] = p
+	}
+
+	var fields []string
+	var selected []*Connection
+	for _, path := range sel {
+		if p, ok := subnames[path]; ok {
+			selected = append(selected, p)
+			continue
+		}
+		if head, _, ok := strings.Cut(path, "."); ok {
+			if p, ok := subnames[head]; ok {
+				selected = append(selected, p)
+				continue
+			}
+		} else if labels[path] {
+			fields = append(fields, path)
+			continue
+		}
+		return nil, errorSelect(path, atom)
+	}
+	if fields == nil {
+		return nil, nil
+	}
+
+	for _, p := range selected {
+		for _, relate := range []map[string]string{p.RelateArgs, p.RelateExtra} {
+			for label := range relate {
+				if labels[label] && !grep(fields, label) {
+					fields = append(fields, label)
+				}
+			}
+		}
+	}
+	return fields, nil
+}
+
+// withFields returns a copy of args with the fields argument set
+func withFields(args any, name, fields string) any {
+	switch t := args.(type) {
+	case map[string]any:
+		hash := cloneMap(t)
+		if hash == nil {
+			hash = make(map[string]any)
+		}
+		hash[name] = fields
+		return hash
+	case []any:
+		var lists []any
+		for _, item := range t {
+			lists = append(lists, withFields(item, name, fields))
+		}
+		return lists
+	case []map[string]any:
+		var lists []any
+		for _, item := range t {
+			lists = append(lists, withFields(item, name, fields))
+		}
+		return lists
+	default:
+	}
+	return map[string]any{name: fields}
+}
@@ -0,0 +1,94 @@
+package godbi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSelect(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE orders (id integer primary key, name varchar(8), note varchar(8))`,
+		`CREATE TABLE item (iid integer primary key, id int, pid int, qty int)`,
+		`CREATE TABLE product (pid integer primary key, title varchar(8), price int)`,
+		`CREATE TABLE payment (yid integer primary key, id int, amount int)`,
+		`INSERT INTO orders VALUES (1, 'a', 'x')`,
+		`INSERT INTO item VALUES (1, 1, 1, 2), (2, 1, 2, 3)`,
+		`INSERT INTO product VALUES (1, 'p', 10), (2, 'q', 20)`,
+		`INSERT INTO payment VALUES (1, 1, 70)`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	molecule := new(Molecule)
+	err = json.Unmarshal([]byte(`{"dbDriver":2,"atoms":[
+{"atomName":"orders","tableName":"orders","pks":["id"],"columns":[{"columnName":"id","typeName":"int","label":"id"},{"columnName":"name","typeName":"string","label":"name"},{"columnName":"note","typeName":"string","label":"note"}],
+ "actions":[{"actionName":"topics","nextpages":[
+	{"atomName":"item","actionName":"topics","relateExtra":{"id":"id"},"marker":"items"},
+	{"atomName":"payment","actionName":"topics","relateExtra":{"id":"id"},"marker":"payments"}]}]},
+{"atomName":"item","tableName":"item","pks":["iid"],"columns":[{"columnName":"iid","typeName":"int","label":"iid"},{"columnName":"id","typeName":"int","label":"id"},{"columnName":"pid","typeName":"int","label":"pid"},{"columnName":"qty","typeName":"int","label":"qty"}],
+ "actions":[{"actionName":"topics","nextpages":[{"atomName":"product","actionName":"edit","relateArgs":{"pid":"pid"},"marker":"product","dimension":"one"}]}]},
+{"atomName":"product","tableName":"product","pks":["pid"],"columns":[{"columnName":"pid","typeName":"int","label":"pid"},{"columnName":"title","typeName":"string","label":"title"},{"columnName":"price","typeName":"int","label":"price"}]},
+{"atomName":"payment","tableName":"payment","pks":["yid"],"columns":[{"columnName":"yid","typeName":"int","label":"yid"},{"columnName":"id","typeName":"int","label":"id"},{"columnName":"amount","typeName":"int","label":"amount"}]}]}`), molecule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	lists, err := molecule.RunContext(ctx, db, "orders", "topics", nil)
+	if err != nil || len(lists) != 1 {
+		t.Fatalf("%v %#v", err, lists)
+	}
+	order := lists[0].(map[string]any)
+	if len(order) != 5 || order["items"] == nil || order["payments"] == nil {
+		t.Errorf("%#v", order)
+	}
+
+	opt := &RunOption{Args: map[string]any{}, Select: []string{"name", "items", "items.qty", "items.product.title"}}
+	lists, err = molecule.RunContext(ctx, db, "orders", "topics", opt)
+	if err != nil || len(lists) != 1 {
+		t.Fatalf("%v %#v", err, lists)
+	}
+	order = lists[0].(map[string]any)
+	// id is read to relate items
+	if len(order) != 3 || order["name"] != "a" || order["id"] != 1 || order["payments"] != nil {
+		t.Errorf("%#v", order)
+	}
+	items := order["items"].([]any)
+	item := items[1].(map[string]any)
+	if len(items) != 2 || len(item) != 3 || item["qty"] != 3 || item["pid"] != 2 {
+		t.Errorf("%#v", items)
+	}
+	if product := item["product"].(map[string]any); len(product) != 1 || product["title"] != "q" {
+		t.Errorf("%#v", product)
+	}
+	if _, ok := opt.Args.(map[string]any)["fields"]; ok {
+		t.Errorf("%#v", opt.Args)
+	}
+
+	lists, err = molecule.RunContext(ctx, db, "orders", "topics", &RunOption{Select: []string{"payments"}})
+	if err != nil || len(lists) != 1 {
+		t.Fatalf("%v %#v", err, lists)
+	}
+	order = lists[0].(map[string]any)
+	if len(order) != 4 || order["note"] != "x" || order["items"] != nil || len(order["payments"].([]any)) != 1 {
+		t.Errorf("%#v", order)
+	}
+
+	for _, sel := range [][]string{{"nothing"}, {"refunds.amount"}, {"items.product.cost"}} {
+		_, err = molecule.RunContext(ctx, db, "orders", "topics", &RunOption{Select: sel})
+		if !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("%v: %v", sel, err)
+		}
+	}
+}
@@ -53,5 +53,5 @@ func (s *Stmt) RunActionContext(ctx context.Context, db *sql.DB, t *Table, args
 	}
 	ids := properValues(pars, args, extra0)
 
-	return getSQL(ctx, db, t.logger, statement, labels, ids...)
+	return getSQL(ctx, t.dbi(db), statement, labels, ids...)
 }
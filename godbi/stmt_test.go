@@ -37,7 +37,7 @@ func TestStmtRun(t *testing.T) {
 
 	db.Exec(`drop table if exists m_a`)
 	db.Exec(`CREATE TABLE m_a (id int auto_increment not null primary key,
-        x varchar(8), y varchar(8), z varchar(8))`)
+        x varchar(8), y varchar(8), z varchar(8), UNIQUE (x, y))`)
 
 	str := `{
     "tableName":"m_a",
@@ -0,0 +1,150 @@
+package godbi
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// StmtCache is a bounded LRU cache of prepared statements keyed by
+// database handle and SQL text. It is safe for concurrent use.
+// A statement evicted while in use is closed after it is released.
+type StmtCache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[stmtKey]*list.Element
+}
+
+type stmtKey struct {
+	db    *sql.DB
+	query string
+}
+
+type cachedStmt struct {
+	key     stmtKey
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+// NewStmtCache returns a cache holding at most size prepared statements
+func NewStmtCache(size int) *StmtCache {
+	if size < 1 {
+		size = 1
+	}
+	return &StmtCache{size: size, ll: list.New(), items: make(map[stmtKey]*list.Element)}
+}
+
+// Len returns the number of statements in the cache
+func (c *StmtCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// StmtContext returns the prepared statement of query, and the function
+// to call when done with it. If tx is not nil, a cached statement is bound
+// to the transaction by Tx.StmtContext; a statement not yet cached is
+// prepared on the transaction only, since preparing it on db would need
+// another connection.
+func (c *StmtCache) StmtContext(ctx context.Context, db *sql.DB, tx *sql.Tx, query string) (*sql.Stmt, func(), error) {
+	if tx == nil {
+		item, err := c.get(ctx, db, query)
+		if err != nil {
+			return nil, nil, err
+		}
+		return item.stmt, func() { c.release(item) }, nil
+	}
+
+	item := c.lookup(db, query)
+	if item == nil {
+		stmt, err := tx.PrepareContext(ctx, query)
+		if err != nil {
+			return nil, nil, err
+		}
+		return stmt, func() { stmt.Close() }, nil
+	}
+	stmt := tx.StmtContext(ctx, item.stmt)
+	return stmt, func() {
+		stmt.Close()
+		c.release(item)
+	}, nil
+}
+
+// lookup returns the cached statement of query with its reference
+// counted, or nil if not found
+func (c *StmtCache) lookup(db *sql.DB, query string) *cachedStmt {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[stmtKey{db, query}]
+	if !ok {
+		return nil
+	}
+	c.ll.MoveToFront(e)
+	item := e.Value.(*cachedStmt)
+	item.refs++
+	return item
+}
+
+func (c *StmtCache) get(ctx context.Context, db *sql.DB, query string) (*cachedStmt, error) {
+	if item := c.lookup(db, query); item != nil {
+		return item, nil
+	}
+
+	stmt, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	key := stmtKey{db, query}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		// prepared by another goroutine meanwhile
+		stmt.Close()
+		c.ll.MoveToFront(e)
+		item := e.Value.(*cachedStmt)
+		item.refs++
+		return item, nil
+	}
+	item := &cachedStmt{key: key, stmt: stmt, refs: 1}
+	c.items[key] = c.ll.PushFront(item)
+	for c.ll.Len() > c.size {
+		c.evict(c.ll.Back())
+	}
+	return item, nil
+}
+
+// evict removes element e from the cache, and closes its statement
+// if not in use. The caller holds the lock.
+func (c *StmtCache) evict(e *list.Element) {
+	item := e.Value.(*cachedStmt)
+	c.ll.Remove(e)
+	delete(c.items, item.key)
+	item.evicted = true
+	if item.refs == 0 {
+		item.stmt.Close()
+	}
+}
+
+func (c *StmtCache) release(item *cachedStmt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item.refs--
+	if item.evicted && item.refs == 0 {
+		item.stmt.Close()
+	}
+}
+
+// Close removes all statements from the cache, and closes them
+// as soon as they are not in use
+func (c *StmtCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.ll.Len() > 0 {
+		c.evict(c.ll.Back())
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package godbi
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStmtCache(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Exec(`INSERT INTO m_a (x) VALUES ('a'), ('b')`)
+
+	table := &Table{
+		TableName: "m_a",
+		Pks:       []string{"id"},
+		IDAuto:    "id",
+		Columns: []*Col{
+			{ColumnName: "id", Label: "id", TypeName: "int", Auto: true},
+			{ColumnName: "x", Label: "x", TypeName: "string", Notnull: true},
+		},
+	}
+	table.SetDBDriver(SQLite)
+	cache := NewStmtCache(2)
+	table.SetStmtCache(cache)
+
+	edit := &Edit{}
+	for _, id := range []int{1, 2, 1} {
+		lists, err := edit.RunAction(db, table, map[string]any{"id": id})
+		if err != nil || len(lists) != 1 {
+			t.Fatalf("%v %#v", err, lists)
+		}
+	}
+	if cache.Len() != 1 {
+		t.Errorf("%d statements cached", cache.Len())
+	}
+
+	topics := &Topics{}
+	for _, args := range []map[string]any{{"sortby": "x"}, {"sortby": "id"}, {"sortby": "x"}} {
+		if _, err = topics.RunAction(db, table, args); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if cache.Len() != 2 {
+		t.Errorf("%d statements cached", cache.Len())
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := ContextWithTx(context.Background(), tx)
+	insert := &Insert{}
+	if _, err = insert.RunActionContext(ctx, db, table, map[string]any{"x": "c"}); err != nil {
+		t.Fatal(err)
+	}
+	// the statements run in the transaction of ctx, with or without cache
+	for _, dbi := range []*DBI{table.dbi(db), {DB: db}} {
+		if _, err = dbi.TxSQLContext(ctx, `INSERT INTO m_a (x) VALUES (?)`, "d"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = dbi.DoSQLsContext(ctx, `INSERT INTO m_a (x) VALUES (?)`, []any{"e"}, []any{"f"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lists, err := topics.RunActionContext(ctx, db, table, map[string]any{})
+	if err != nil || len(lists) != 9 {
+		t.Errorf("%v %#v", err, lists)
+	}
+	if err = tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	lists, err = topics.RunAction(db, table, map[string]any{})
+	if err != nil || len(lists) != 2 {
+		t.Errorf("%v %#v", err, lists)
+	}
+
+	cache.Close()
+	if cache.Len() != 0 {
+		t.Errorf("%d statements after close", cache.Len())
+	}
+}
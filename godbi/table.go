@@ -22,22 +22,80 @@ type Col struct {
 type Fk struct {
 	// the parent table name
 	FkTable string `json:"fkTable" hcl:"fkTable,optional"`
+	// the schema of parent table, if not the default
+	FkSchema string `json:"fkSchema,omitempty" hcl:"fkSchema,optional"`
 	// the parant table column
-	FkColumn string `json:"fkColumn" hcl:"fkColumn,optional"`
+	FkColumn string `json:"fkColumn,omitempty" hcl:"fkColumn,optional"`
 	// column of this table
-	Column string `json:"column" hcl:"column,optional"`
+	Column string `json:"column,omitempty" hcl:"column,optional"`
+	// the parent table columns, for a composite foreign key
+	FkColumns []string `json:"fkColumns,omitempty" hcl:"fkColumns,optional"`
+	// columns of this table, for a composite foreign key
+	Columns []string `json:"columns,omitempty" hcl:"columns,optional"`
+}
+
+// NewFk returns a foreign key from columns of this table to columns of
+// the parent table. A single column key is set in FkColumn and Column.
+func NewFk(fkTable string, fkColumns, columns []string) *Fk {
+	if len(fkColumns) == 1 && len(columns) == 1 {
+		return &Fk{FkTable: fkTable, FkColumn: fkColumns[0], Column: columns[0]}
+	}
+	return &Fk{FkTable: fkTable, FkColumns: fkColumns, Columns: columns}
+}
+
+// GetFkTable returns the parent table name, qualified by schema if any
+func (f *Fk) GetFkTable() string {
+	return qualifiedName(f.FkSchema, f.FkTable)
+}
+
+// GetFkColumns returns the parent table columns of the foreign key
+func (f *Fk) GetFkColumns() []string {
+	if f.FkColumns != nil {
+		return f.FkColumns
+	}
+	if f.FkColumn != "" {
+		return []string{f.FkColumn}
+	}
+	return nil
+}
+
+// GetColumns returns columns of this table in the foreign key
+func (f *Fk) GetColumns() []string {
+	if f.Columns != nil {
+		return f.Columns
+	}
+	if f.Column != "" {
+		return []string{f.Column}
+	}
+	return nil
+}
+
+// Relate maps the parent table columns to columns of this table
+func (f *Fk) Relate() map[string]string {
+	fkColumns := f.GetFkColumns()
+	columns := f.GetColumns()
+	if len(fkColumns) != len(columns) {
+		return nil
+	}
+	relate := make(map[string]string)
+	for i, fkColumn := range fkColumns {
+		relate[fkColumn] = columns[i]
+	}
+	return relate
 }
 
 // Table defines a table by name, columns, primary key, foreign keys, auto id and unique columns
 type Table struct {
 	TableName string   `json:"tableName" hcl:"tableName,optional"`
+	Schema    string   `json:"schema,omitempty" hcl:"schema,optional"`
 	Columns   []*Col   `json:"columns" hcl:"columns,block"`
 	Pks       []string `json:"pks,omitempty" hcl:"pks,optional"`
 	IDAuto    string   `json:"idAuto,omitempty" hcl:"idAuto,optional"`
 	Fks       []*Fk    `json:"fks,omitempty" hcl:"fks,block"`
 	Uniques   []string `json:"uniques,omitempty" hcl:"uniques,optional"`
-	dbDriver  DBType
+	dialect   Dialect
 	logger    Slogger
+	cache     *StmtCache
 }
 
 // SetLogger sets the logger
@@ -53,27 +111,89 @@ func (t *Table) GetLogger() Slogger {
 // IsRecursive indicates if table references to itself in one to multiple relations
 func (t *Table) IsRecursive() bool {
 	for _, col := range t.Columns {
-		if col.ColumnName == t.Pks[0] && col.Recurse {
+		if col.Recurse && grep(t.Pks, col.ColumnName) {
 			return true
 		}
 	}
 	return false
 }
 
-// RecursiveColumn returns the name of the resursive column
+// RecursiveColumn returns the name of the resursive column.
+// For a composite primary key, use RecursiveColumns.
 func (t *Table) RecursiveColumn() string {
+	if cols := t.RecursiveColumns(); cols != nil {
+		return cols[0]
+	}
+	return ""
+}
+
+// RecursiveColumns returns the names of the resursive columns,
+// which reference to all columns of the primary key.
+func (t *Table) RecursiveColumns() []string {
+	var cols []string
 	for _, col := range t.Columns {
-		if col.ColumnName == t.Pks[0] || !col.Recurse {
+		if !col.Recurse || grep(t.Pks, col.ColumnName) {
 			continue
 		}
-		return col.ColumnName
+		cols = append(cols, col.ColumnName)
 	}
-	return ""
+	return cols
+}
+
+// SetStmtCache sets the prepared statement cache
+func (t *Table) SetStmtCache(cache *StmtCache) {
+	t.cache = cache
 }
 
-// SetDBDriver sets the driver type
+// dbi returns the database interface with the logger and statement cache
+func (t *Table) dbi(db *sql.DB) *DBI {
+	return &DBI{DB: db, logger: t.logger, cache: t.cache, table: t}
+}
+
+// SetDBDriver sets the dialect by the built-in driver type
 func (t *Table) SetDBDriver(driver DBType) {
-	t.dbDriver = driver
+	t.dialect = driver.Dialect()
+}
+
+// SetDialect sets the dialect
+func (t *Table) SetDialect(dialect Dialect) {
+	t.dialect = dialect
+}
+
+// GetDialect gets the dialect, which defaults to generic SQL
+func (t *Table) GetDialect() Dialect {
+	if t.dialect == nil {
+		return defaultDialect{}
+	}
+	return t.dialect
+}
+
+// quote quotes the identifier name according to the dialect
+func (t *Table) quote(name string) string {
+	return t.GetDialect().Quote(name)
+}
+
+// quoteList quotes and joins the identifier names
+func (t *Table) quoteList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = t.quote(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// QualifiedName returns the table name qualified by schema if any
+func (t *Table) QualifiedName() string {
+	return qualifiedName(t.Schema, t.TableName)
+}
+
+// quotedName returns the quoted, schema-qualified, table name
+func (t *Table) quotedName() string {
+	if t.Schema == "" {
+		return t.quote(t.TableName)
+	}
+	dialect := t.GetDialect()
+	return dialect.Quote(t.Schema) + "." + dialect.Quote(t.TableName)
 }
 
 func (t *Table) byConstraint(args map[string]any, extra ...map[string]any) map[string]any {
@@ -164,55 +284,54 @@ func (t *Table) refreshArgs(args any, force ...bool) any {
 }
 
 func (t *Table) getKeyColumns() []string {
-	labels := make(map[string]bool)
+	var outs []string
+	add := func(name string) {
+		if !grep(outs, name) {
+			outs = append(outs, name)
+		}
+	}
 	for _, pk := range t.Pks {
-		labels[pk] = true
+		add(pk)
 	}
 	if t.IDAuto != "" {
-		labels[t.IDAuto] = true
+		add(t.IDAuto)
 	}
-	if t.Fks != nil {
-		for _, fk := range t.Fks {
-			labels[fk.Column] = true
+	for _, fk := range t.Fks {
+		for _, column := range fk.GetColumns() {
+			add(column)
 		}
 	}
-
-	var outs []string
-	for k := range labels {
-		outs = append(outs, k)
-	}
 	return outs
 }
 
-func (t *Table) getFv(args map[string]any, allowed map[string]bool) (map[string]any, bool) {
+func (t *Table) getFv(args map[string]any, allowed map[string]bool) (map[string]any, bool, error) {
 	fieldValues := make(map[string]any)
-	for f, l := range t.insertCols(allowed) {
+	for _, col := range t.Columns {
+		if col.Auto || (allowed != nil && !allowed[col.Label]) {
+			continue
+		}
+		f := col.ColumnName
 		v, ok := args[f]
 		if !ok {
-			v, ok = args[l]
-		}
-		if ok {
-			switch val := v.(type) {
-			case []map[string]any, map[string]any:
-			case bool:
-				switch t.dbDriver {
-				case SQLite:
-					if val {
-						fieldValues[f] = 1
-					} else {
-						fieldValues[f] = 0
-					}
-				default:
-					if val {
-						fieldValues[f] = "true"
-					} else {
-						fieldValues[f] = "false"
-					}
-				}
-			default:
-				fieldValues[f] = val
-			}
+			v, ok = args[col.Label]
+		}
+		if !ok {
+			continue
 		}
+		switch v.(type) {
+		case []map[string]any, map[string]any, []any:
+			continue
+		default:
+		}
+		val, err := coerceValue(col.TypeName, v)
+		if err != nil {
+			return nil, false, errorCoerce(f, col.TypeName, v, err)
+		}
+		if b, ok := val.(bool); ok {
+			fieldValues[f] = t.GetDialect().Bool(b)
+			continue
+		}
+		fieldValues[f] = val
 	}
 
 	allAuto := true
@@ -223,7 +342,7 @@ func (t *Table) getFv(args map[string]any, allowed map[string]bool) (map[string]
 		}
 	}
 
-	return fieldValues, allAuto
+	return fieldValues, allAuto, nil
 }
 
 func (t *Table) checkNull(args map[string]any, extra ...map[string]any) error {
@@ -245,93 +364,98 @@ func (t *Table) checkNull(args map[string]any, extra ...map[string]any) error {
 	return nil
 }
 
-func (t *Table) insertCols(allowed map[string]bool) map[string]string {
-	cols := make(map[string]string)
-	for _, col := range t.Columns {
-		if col.Auto {
-			continue
-		}
-		if allowed != nil && !allowed[col.Label] {
-			continue
+func (t *Table) insertHashContext(ctx context.Context, db *sql.DB, args map[string]any) (int64, error) {
+	fields, values := insertPars(args)
+
+	dialect := t.GetDialect()
+	query := t.insertSQL(fields)
+	dbi := t.dbi(db)
+	if t.IDAuto != "" {
+		if serial := dialect.InsertID(query, t.quote(t.IDAuto)); serial != "" {
+			return dbi.InsertSerialContext(ctx, dialect.Placeholder(serial), values...)
 		}
-		cols[col.ColumnName] = col.Label
 	}
-	return cols
+
+	res, err := dbi.DoSQLContext(ctx, dialect.Placeholder(query), values...)
+	if err != nil {
+		return 0, err
+	}
+	lastID, err := res.LastInsertId()
+	if err != nil && t.IDAuto != "" {
+		return 0, err
+	}
+	return lastID, nil
 }
 
-func (t *Table) insertHashContext(ctx context.Context, db *sql.DB, args map[string]any) (int64, error) {
+// insertPars returns the fields of non-nil values in args, sorted, and their values
+func insertPars(args map[string]any) ([]string, []any) {
 	var fields []string
 	var values []any
-	for k, v := range args {
-		if v != nil {
+	for _, k := range sortedKeys(args) {
+		if v := args[k]; v != nil {
 			fields = append(fields, k)
 			values = append(values, v)
 		}
 	}
+	return fields, values
+}
 
-	query := "INSERT INTO " + t.TableName + " (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(strings.Split(strings.Repeat("?", len(fields)), ""), ",") + ")"
+// insertSQL returns the INSERT statement of fields, with question marks
+func (t *Table) insertSQL(fields []string) string {
+	query := "INSERT INTO " + t.quotedName()
+	if !hasValue(fields) {
+		return query + " " + t.GetDialect().DefaultValues()
+	}
+	return query + " (" + t.quoteList(fields) + ") VALUES (" + strings.Join(strings.Split(strings.Repeat("?", len(fields)), ""), ",") + ")"
+}
 
-	dbi := &DBI{DB: db, logger: t.logger}
-	var lastID int64
-	var err error
-	switch t.dbDriver {
-	case Postgres:
-		query = questionMarkerNumber(query)
-		if t.IDAuto != "" {
-			query += " RETURNING " + t.IDAuto
-			lastID, err = dbi.InsertSerialContext(ctx, query, values...)
-		} else {
-			_, err = dbi.DoSQLContext(ctx, query, values...)
-		}
-	case SQLite:
-		if hasValue(values) {
-			lastID, err = dbi.InsertIDContext(ctx, query, values...)
-		} else {
-			lastID, err = dbi.InsertIDContext(ctx, "INSERT INTO "+t.TableName+" DEFAULT VALUES")
-		}
-	case SQLRaw:
-		var res sql.Result
-		res, err = dbi.DoSQLContext(ctx, query, values...)
-		if err == nil {
-			lastID, _ = res.LastInsertId()
-		}
-	default:
-		lastID, err = dbi.InsertIDContext(ctx, query, values...)
+func (t *Table) updateHashNullsContext(ctx context.Context, db *sql.DB, args map[string]any, ids []any, empties []string, extra ...map[string]any) (int64, error) {
+	sql, values, err := t.updateHashNullsSQL(args, ids, empties, extra...)
+	if err != nil {
+		return 0, err
 	}
+	dbi := t.dbi(db)
+	res, err := dbi.DoSQLContext(ctx, sql, values...)
 	if err != nil {
 		return 0, err
 	}
-	return lastID, nil
+	return res.RowsAffected()
 }
 
-func (t *Table) updateHashNullsContext(ctx context.Context, db *sql.DB, args map[string]any, ids []any, empties []string, extra ...map[string]any) error {
+// matchedContext counts the rows of ids and extra. An update writing the
+// same values matches a row which MySQL does not report as affected.
+func (t *Table) matchedContext(ctx context.Context, db *sql.DB, ids []any, extra ...map[string]any) (int64, error) {
+	where, values := t.singleCondition(ids, "", extra...)
+	sql := "SELECT COUNT(*) FROM " + t.quotedName() + "\nWHERE " + where
+	var n int64
+	err := t.dbi(db).queryRowScanContext(ctx, t.GetDialect().Placeholder(sql), values, &n)
+	return n, err
+}
+
+func (t *Table) updateHashNullsSQL(args map[string]any, ids []any, empties []string, extra ...map[string]any) (string, []any, error) {
 	if !hasValue(args) {
-		return errorEmptyInput(t.TableName)
+		return "", nil, errorEmptyInput(t.TableName)
 	}
 	for _, k := range t.Pks {
 		if grep(empties, k) {
-			return errorMissingPk(t.TableName)
+			return "", nil, errorMissingPk(t.TableName)
 		}
 	}
 
-	n := len(args)
-	fields := make([]string, n)
-	field0 := make([]string, n)
-	values := make([]any, n)
-	i := 0
-	for k, v := range args {
-		fields[i] = k
-		field0[i] = k + "=?"
-		values[i] = v
-		i++
+	keys := sortedKeys(args)
+	field0 := make([]string, len(keys))
+	values := make([]any, len(keys))
+	for i, k := range keys {
+		field0[i] = t.quote(k) + "=?"
+		values[i] = args[k]
 	}
 
-	sql := "UPDATE " + t.TableName + " SET " + strings.Join(field0, ", ")
+	sql := "UPDATE " + t.quotedName() + " SET " + strings.Join(field0, ", ")
 	for _, v := range empties {
 		if _, ok := args[v]; ok {
 			continue
 		}
-		sql += ", " + v + "=NULL"
+		sql += ", " + t.quote(v) + "=NULL"
 	}
 
 	where, extraValues := t.singleCondition(ids, "", extra...)
@@ -340,82 +464,224 @@ func (t *Table) updateHashNullsContext(ctx context.Context, db *sql.DB, args map
 		values = append(values, extraValues...)
 	}
 
-	dbi := &DBI{DB: db, logger: t.logger}
-	if t.dbDriver == Postgres {
-		sql = questionMarkerNumber(sql)
+	return t.GetDialect().Placeholder(sql), values, nil
+}
+
+// labelOf returns the label of column name
+func (t *Table) labelOf(name string) string {
+	for _, col := range t.Columns {
+		if col.ColumnName == name {
+			return col.Label
+		}
+	}
+	return name
+}
+
+// hasReturning tells if the database accepts RETURNING in INSERT and UPDATE
+func (t *Table) hasReturning() bool {
+	return t.GetDialect().Returning()
+}
+
+// returningPars returns all columns for RETURNING and their typed labels
+func (t *Table) returningPars() (string, []any) {
+	var keys []string
+	var labels []any
+	for _, col := range t.Columns {
+		keys = append(keys, col.ColumnName)
+		labels = append(labels, [2]string{col.Label, col.TypeName})
+	}
+	return t.quoteList(keys), labels
+}
+
+// rowContext re-selects the whole row by primary key values
+func (t *Table) rowContext(ctx context.Context, db *sql.DB, ids []any) (map[string]any, error) {
+	if !hasValue(ids) {
+		return nil, errorMissingPk(t.TableName)
 	}
-	_, err := dbi.DoSQLContext(ctx, sql, values...)
-	return err
+	keys, labels := t.returningPars()
+	where, values := t.singleCondition(ids, "")
+	sql := "SELECT " + keys + "\nFROM " + t.quotedName() + "\nWHERE " + where
+	return t.firstRowContext(ctx, db, t.GetDialect().Placeholder(sql), labels, values...)
+}
+
+func (t *Table) firstRowContext(ctx context.Context, db *sql.DB, sql string, labels []any, values ...any) (map[string]any, error) {
+	lists, err := getSQL(ctx, t.dbi(db), sql, labels, values...)
+	if err != nil {
+		return nil, err
+	}
+	if len(lists) == 0 {
+		return nil, nil
+	}
+	return lists[0].(map[string]any), nil
+}
+
+// insertReturningContext inserts a row and returns the whole persisted row,
+// using RETURNING if the database supports it, or re-selecting by primary key.
+func (t *Table) insertReturningContext(ctx context.Context, db *sql.DB, args map[string]any) (map[string]any, error) {
+	if !t.hasReturning() {
+		// the row is read back by the primary key, which must be known
+		for _, pk := range t.Pks {
+			if pk != t.IDAuto && args[pk] == nil {
+				return nil, errorMissingPkValue(pk, t.TableName)
+			}
+		}
+		autoID, err := t.insertHashContext(ctx, db, args)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]any, len(t.Pks))
+		for i, pk := range t.Pks {
+			if pk == t.IDAuto {
+				ids[i] = autoID
+			} else {
+				ids[i] = args[pk]
+			}
+		}
+		return t.rowContext(ctx, db, ids)
+	}
+
+	fields, values := insertPars(args)
+
+	keys, labels := t.returningPars()
+	query := t.insertSQL(fields) + " RETURNING " + keys
+	return t.firstRowContext(ctx, db, t.GetDialect().Placeholder(query), labels, values...)
+}
+
+// updateReturningContext updates a row and returns the whole persisted row,
+// using RETURNING if the database supports it, or re-selecting by primary key.
+func (t *Table) updateReturningContext(ctx context.Context, db *sql.DB, args map[string]any, ids []any, empties []string, extra ...map[string]any) (map[string]any, error) {
+	if !t.hasReturning() {
+		if _, err := t.updateHashNullsContext(ctx, db, args, ids, empties, extra...); err != nil {
+			return nil, err
+		}
+		return t.rowContext(ctx, db, ids)
+	}
+
+	sql, values, err := t.updateHashNullsSQL(args, ids, empties, extra...)
+	if err != nil {
+		return nil, err
+	}
+	keys, labels := t.returningPars()
+	return t.firstRowContext(ctx, db, sql+"\nRETURNING "+keys, labels, values...)
 }
 
 func (t *Table) insupdTableContext(ctx context.Context, db *sql.DB, args map[string]any) (int64, error) {
+	changed, _, err := t.insupdReturningContext(ctx, db, args, false)
+	return changed, err
+}
+
+// insupdReturningContext updates the row found by the unique key,
+// or inserts a new row if not found. If returning is true,
+// the whole persisted row is returned as well.
+func (t *Table) insupdReturningContext(ctx context.Context, db *sql.DB, args map[string]any, returning bool) (int64, map[string]any, error) {
 	changed := int64(0)
-	s := "SELECT " + strings.Join(t.Pks, ", ") + " FROM " + t.TableName + "\nWHERE "
+	s := "SELECT " + t.quoteList(t.Pks) + " FROM " + t.quotedName() + "\nWHERE "
 	var v []any
 	if t.Uniques == nil {
-		return changed, errorNoUniqueKey(t.TableName)
+		return changed, nil, errorNoUniqueKey(t.TableName)
 	}
 	for i, val := range t.Uniques {
 		if i > 0 {
 			s += " AND "
 		}
-		s += val + "=?"
+		s += t.quote(val) + "=?"
 		if x, ok := args[val]; ok {
 			v = append(v, x)
 		} else {
-			return changed, errorEmptyInput(val)
+			return changed, nil, errorEmptyInput(val)
 		}
 	}
 
-	lists := make([]any, 0)
-	dbi := &DBI{DB: db, logger: t.logger}
-	if t.dbDriver == Postgres {
-		s = questionMarkerNumber(s)
+	dialect := t.GetDialect()
+	dbi := t.dbi(db)
+	upsert, values := t.upsertSQL(args)
+	merged := upsert != ""
+	if merged {
+		if _, err := dbi.DoSQLContext(ctx, upsert, values...); err != nil {
+			return changed, nil, err
+		}
 	}
-	err := dbi.SelectContext(ctx, &lists, s, v...)
+
+	lists := make([]any, 0)
+	err := dbi.SelectContext(ctx, &lists, dialect.Placeholder(s), v...)
 	if err != nil {
-		return changed, err
+		return changed, nil, err
 	}
 	if len(lists) > 1 {
-		return changed, errorNotUnique(t.TableName)
+		return changed, nil, errorNotUnique(t.TableName)
 	}
 
+	var row map[string]any
 	if len(lists) == 1 {
 		ids := make([]any, 0)
 		for _, k := range t.Pks {
 			ids = append(ids, lists[0].(map[string]any)[k])
 		}
-		err = t.updateHashNullsContext(ctx, db, args, ids, nil)
+		switch {
+		case merged && returning:
+			row, err = t.rowContext(ctx, db, ids)
+		case merged:
+		case returning:
+			row, err = t.updateReturningContext(ctx, db, args, ids, nil)
+		default:
+			_, err = t.updateHashNullsContext(ctx, db, args, ids, nil)
+		}
 		if err == nil && t.IDAuto != "" {
-			sql := "SELECT " + t.IDAuto + " FROM " + t.TableName + "\nWHERE " + strings.Join(t.Pks, "=? AND ") + "=?"
-			if t.dbDriver == Postgres {
-				sql = questionMarkerNumber(sql)
-			}
-			err = db.QueryRowContext(ctx, sql, ids...).Scan(&changed)
-			return changed, err
+			where, values := t.singleCondition(ids, "")
+			sql := "SELECT " + t.quote(t.IDAuto) + " FROM " + t.quotedName() + "\nWHERE " + where
+			err = dbi.queryRowScanContext(ctx, dialect.Placeholder(sql), values, &changed)
+		}
+		return changed, row, err
+	}
+	if merged {
+		return changed, nil, errorNotFound(t.TableName)
+	}
+
+	if returning {
+		row, err = t.insertReturningContext(ctx, db, args)
+		if err == nil && t.IDAuto != "" && row != nil {
+			changed, _ = toInt64(row[t.labelOf(t.IDAuto)])
 		}
 	} else {
 		changed, err = t.insertHashContext(ctx, db, args)
 	}
+	return changed, row, err
+}
 
-	return changed, err
+// upsertSQL returns the single statement inserting args, or updating the
+// row of the same unique key, and its values. The statement is empty if
+// the dialect writes none, so the row is looked up before the change.
+func (t *Table) upsertSQL(args map[string]any) (string, []any) {
+	fields, values := insertPars(args)
+	dialect := t.GetDialect()
+	if merger, ok := dialect.(Merger); ok {
+		return dialect.Placeholder(merger.Merge(t.quotedName(), t.Uniques, fields)), values
+	}
+	var updates []string
+	for _, field := range fields {
+		if !grep(t.Uniques, field) {
+			updates = append(updates, field)
+		}
+	}
+	clause := dialect.Upsert(t.Uniques, updates)
+	if clause == "" {
+		return "", nil
+	}
+	return dialect.Placeholder(t.insertSQL(fields) + " " + clause), values
 }
 
 func (t *Table) totalHashContext(ctx context.Context, db *sql.DB, v any, extra ...map[string]any) error {
-	sql := "SELECT COUNT(*) FROM " + t.TableName
+	sql := "SELECT COUNT(*) FROM " + t.quotedName()
 
 	if hasValue(extra) {
-		where, values := selectCondition(extra[0], "")
+		where, values := t.selectCondition(extra[0], "")
 		if where != "" {
 			sql += "\nWHERE " + where
 		}
-		if t.dbDriver == Postgres {
-			sql = questionMarkerNumber(sql)
-		}
-		return db.QueryRowContext(ctx, sql, values...).Scan(v)
+		return t.dbi(db).queryRowScanContext(ctx, t.GetDialect().Placeholder(sql), values, v)
 	}
 
-	return db.QueryRowContext(ctx, sql).Scan(v)
+	return t.dbi(db).queryRowScanContext(ctx, sql, nil, v)
 }
 
 func (t *Table) getIDVal(args map[string]any, extra ...map[string]any) []any {
@@ -425,6 +691,84 @@ func (t *Table) getIDVal(args map[string]any, extra ...map[string]any) []any {
 	return properValues(t.Pks, args, nil)
 }
 
+// coerceIDs converts the pk values in ids, and the values in lists of
+// ids, into the types of the pk columns
+func (t *Table) coerceIDs(ids []any) ([]any, error) {
+	outs := make([]any, len(ids))
+	for i, id := range ids {
+		if i >= len(t.Pks) {
+			outs[i] = id
+			continue
+		}
+		val, err := t.coerceWhere(t.Pks[i], id)
+		if err != nil {
+			return nil, err
+		}
+		outs[i] = val
+	}
+	return outs, nil
+}
+
+// coerceExtra returns a copy of extra whose values are converted into the
+// types of the columns named, by column name or label, by their keys
+func (t *Table) coerceExtra(extra ...map[string]any) ([]map[string]any, error) {
+	if !hasValue(extra) || !hasValue(extra[0]) {
+		return extra, nil
+	}
+	outs := make(map[string]any, len(extra[0]))
+	for k, v := range extra[0] {
+		val, err := t.coerceWhere(k, v)
+		if err != nil {
+			return nil, err
+		}
+		outs[k] = val
+	}
+	return append([]map[string]any{outs}, extra[1:]...), nil
+}
+
+// coerceWhere converts v, a value or a list of values in a where
+// condition, into the type of column name
+func (t *Table) coerceWhere(name string, v any) (any, error) {
+	var col *Col
+	for _, item := range t.Columns {
+		if item.ColumnName == name || item.Label == name {
+			col = item
+			break
+		}
+	}
+	if col == nil {
+		return v, nil
+	}
+
+	coerce := func(item any) (any, error) {
+		val, err := coerceValue(col.TypeName, item)
+		if err != nil {
+			return nil, errorCoerce(col.ColumnName, col.TypeName, item, err)
+		}
+		if b, ok := val.(bool); ok {
+			return t.GetDialect().Bool(b), nil
+		}
+		return val, nil
+	}
+
+	switch value := v.(type) {
+	case []any:
+		outs := make([]any, len(value))
+		for i, item := range value {
+			val, err := coerce(item)
+			if err != nil {
+				return nil, err
+			}
+			outs[i] = val
+		}
+		return outs, nil
+	case existsCondition, []int, []int64, []string, []map[string]any, map[string]any:
+		return v, nil
+	default:
+	}
+	return coerce(v)
+}
+
 func (t *Table) singleCondition(ids []any, table string, extra ...map[string]any) (string, []any) {
 	keys := t.Pks
 	sql := ""
@@ -440,17 +784,17 @@ func (t *Table) singleCondition(ids []any, table string, extra ...map[string]any
 		switch idValues := val.(type) {
 		case []any:
 			n := len(idValues)
-			sql += item + " IN (" + strings.Join(strings.Split(strings.Repeat("?", n), ""), ",") + ")"
+			sql += t.quote(item) + " IN (" + strings.Join(strings.Split(strings.Repeat("?", n), ""), ",") + ")"
 			extraValues = append(extraValues, idValues...)
 		default:
-			sql += item + " =?"
+			sql += t.quote(item) + " =?"
 			extraValues = append(extraValues, val)
 		}
 	}
 	sql += ")"
 
 	if hasValue(extra) && hasValue(extra[0]) {
-		s, arr := selectCondition(extra[0], table)
+		s, arr := t.selectCondition(extra[0], table)
 		sql += " AND " + s
 		extraValues = append(extraValues, arr...)
 	}
@@ -495,24 +839,31 @@ func properValuesHash(vs []string, args map[string]any, extra map[string]any) ma
 	return hash
 }
 
-func selectCondition(extra map[string]any, table string) (string, []any) {
+func (t *Table) selectCondition(extra map[string]any, table string) (string, []any) {
 	sql := ""
 	var values []any
 	i := 0
 
-	for field, valueInterface := range extra {
+	for _, field := range sortedKeys(extra) {
+		valueInterface := extra[field]
 		if i > 0 {
 			sql += " AND "
 		}
 		i++
 		sql += "("
 
-		if table != "" {
-			if !strings.Contains(field, ".") {
-				field = table + "." + field
+		raw := strings.HasSuffix(field, "_gsql")
+		if !raw {
+			if table != "" && !strings.Contains(field, ".") {
+				field = t.quote(table) + "." + t.quote(field)
+			} else {
+				field = t.quote(field)
 			}
 		}
 		switch value := valueInterface.(type) {
+		case existsCondition:
+			sql += value.sql
+			values = append(values, value.values...)
 		case []int:
 			n := len(value)
 			sql += field + " IN (" + strings.Join(strings.Split(strings.Repeat("?", n), ""), ",") + ")"
@@ -532,8 +883,7 @@ func selectCondition(extra map[string]any, table string) (string, []any) {
 				values = append(values, v)
 			}
 		case string:
-			n := len(field)
-			if n >= 5 && field[(n-5):] == "_gsql" {
+			if raw {
 				sql += value
 			} else {
 				sql += field + " =?"
@@ -579,7 +929,7 @@ func (t *Table) filterPars(args map[string]any, fieldsName string, allowed map[s
 		}
 	}
 
-	return "SELECT " + strings.Join(keys, ", ") + "\nFROM " + t.TableName, labels
+	return "SELECT " + t.quoteList(keys) + "\nFROM " + t.quotedName(), labels
 }
 
 /*
@@ -1,6 +1,7 @@
 package godbi
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	// "github.com/genelet/horizon/dethcl"
@@ -40,10 +41,6 @@ func TestTable(t *testing.T) {
 	if table.TableName != "adv_campaign" {
 		t.Errorf("%v", table)
 	}
-	inCols := table.insertCols(nil)
-	if inCols["adv_id"] != "adv_id" || inCols["campaign_name"] != "campaign_name" {
-		t.Errorf("%v", table)
-	}
 }
 
 func TestHCLTable(t *testing.T) {
@@ -89,3 +86,98 @@ func TestHCLTable(t *testing.T) {
 	   t.Errorf("%s", bs)
 	*/
 }
+
+func TestTableCompositeRecursive(t *testing.T) {
+	table := &Table{
+		TableName: "node",
+		Pks:       []string{"tree_id", "node_id"},
+		Columns: []*Col{
+			{ColumnName: "tree_id", Label: "tree_id", TypeName: "int"},
+			{ColumnName: "node_id", Label: "node_id", TypeName: "int", Recurse: true},
+			{ColumnName: "parent_tree_id", Label: "parent_tree_id", TypeName: "int", Recurse: true},
+			{ColumnName: "parent_node_id", Label: "parent_node_id", TypeName: "int", Recurse: true},
+		},
+	}
+	if !table.IsRecursive() {
+		t.Errorf("%#v", table)
+	}
+	cols := table.RecursiveColumns()
+	if len(cols) != 2 || cols[0] != "parent_tree_id" || cols[1] != "parent_node_id" || table.RecursiveColumn() != "parent_tree_id" {
+		t.Errorf("%v", cols)
+	}
+
+	args := []any{map[string]any{"tree_id": 1, "node_id": 2, "x": 3}}
+	deleteKeys(args, table.Pks)
+	if item := args[0].(map[string]any); len(item) != 1 || item["x"] != 3 {
+		t.Errorf("%v", args)
+	}
+
+	if (&Table{TableName: "empty"}).IsRecursive() {
+		t.Errorf("table without pk is not recursive")
+	}
+}
+
+func TestTableCompositeDelecs(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	db.Exec(`CREATE TABLE m_c (a int, b int, p int, q varchar(8), PRIMARY KEY (a, b))`)
+	db.Exec(`INSERT INTO m_c VALUES (1, 1, 10, 'x'), (1, 2, 20, 'y'), (2, 1, 30, 'z')`)
+
+	table := &Table{
+		TableName: "m_c",
+		Pks:       []string{"a", "b"},
+		Fks:       []*Fk{{FkTable: "m_p", FkColumn: "p", Column: "p"}},
+		Columns: []*Col{
+			{ColumnName: "a", Label: "a", TypeName: "int"},
+			{ColumnName: "b", Label: "b", TypeName: "int"},
+			{ColumnName: "p", Label: "p", TypeName: "int"},
+			{ColumnName: "q", Label: "q", TypeName: "string"},
+		},
+	}
+	table.SetDBDriver(SQLite)
+	delecs := new(Delecs)
+	lists, err := delecs.RunAction(db, table, map[string]any{"a": 1, "b": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists) != 1 || lists[0].(map[string]any)["p"] != "20" {
+		t.Errorf("%#v", lists)
+	}
+}
+
+func TestTableSchema(t *testing.T) {
+	db, err := sql.Open("golden", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	table := &Table{
+		TableName: "orders",
+		Schema:    "sales",
+		Pks:       []string{"id"},
+		Columns: []*Col{
+			{ColumnName: "id", Label: "id", TypeName: "int"},
+			{ColumnName: "x", Label: "x", TypeName: "string", Constraint: true},
+		},
+	}
+	table.SetDBDriver(Postgres)
+	if table.QualifiedName() != "sales.orders" {
+		t.Errorf("%s", table.QualifiedName())
+	}
+
+	golden.log = nil
+	edit := &Edit{}
+	if _, err = edit.RunAction(db, table, map[string]any{"id": 1, "x": "a"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := "SELECT \"id\", \"x\"\nFROM \"sales\".\"orders\"\nWHERE (\"id\" =$1) AND (\"sales\".\"orders\".\"x\" =$2)\n-- [1 a]"
+	if len(golden.log) != 1 || golden.log[0] != expected {
+		t.Errorf("%q", golden.log)
+	}
+}
@@ -0,0 +1,45 @@
+package godbi
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// parseTimeout parses the timeout of name, e.g. 500ms or 2s.
+// An empty timeout is 0, meaning no timeout.
+func parseTimeout(name, timeout string) (time.Duration, error) {
+	if timeout == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(timeout)
+	if err != nil || d < 0 {
+		return 0, errorTimeoutValue(name, timeout)
+	}
+	return d, nil
+}
+
+// withTimeout runs f in a child context of ctx bounded by timeout. If f
+// fails because the child context, not ctx, expired, the error reports
+// that name exceeded its timeout.
+func withTimeout(ctx context.Context, name, timeout string, f func(context.Context) ([]any, error)) ([]any, error) {
+	d, err := parseTimeout(name, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if d == 0 {
+		return f(ctx)
+	}
+
+	child, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	lists, err := f(child)
+	if err == nil || errors.Is(err, ErrTimeout) || ctx.Err() != nil || child.Err() != context.DeadlineExceeded {
+		return lists, err
+	}
+	// keep the path outermost
+	if e, ok := err.(*PathError); ok {
+		return nil, &PathError{Path: e.Path, Err: errorTimeout(name, d, e.Err)}
+	}
+	return nil, errorTimeout(name, d, err)
+}
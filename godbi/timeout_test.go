@@ -0,0 +1,163 @@
+package godbi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sleeper sleeps for Delay, or until the context is done
+type sleeper struct {
+	Action
+	Delay string `json:"delay,omitempty" hcl:"delay,optional"`
+}
+
+func (s *sleeper) RunActionContext(ctx context.Context, db *sql.DB, t *Table, args map[string]any, extra ...map[string]any) ([]any, error) {
+	d, err := time.ParseDuration(s.Delay)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case <-time.After(d):
+		return []any{map[string]any{"slept": s.Delay}}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func init() {
+	RegisterCapability("sleep", &sleeper{})
+}
+
+func TestTimeout(t *testing.T) {
+	db, err := getsqlite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE m_a (id integer primary key autoincrement, x varchar(8) not null)`,
+		`INSERT INTO m_a (x) VALUES ('a')`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	newMolecule := func() *Molecule {
+		molecule := new(Molecule)
+		err := json.Unmarshal([]byte(`{"dbDriver":2,"atoms":[
+{"atomName":"m_a","tableName":"m_a","pks":["id"],"columns":[{"columnName":"id","typeName":"int","label":"id"},{"columnName":"x","typeName":"string","label":"x"}],"actions":[
+	{"actionName":"topics","nextpages":[{"atomName":"m_b","actionName":"sleep","relateArgs":{"id":"id"},"timeout":"20ms"}]},
+	{"actionName":"sleep","delay":"1s","timeout":"20ms"}]},
+{"atomName":"m_b","tableName":"m_a","pks":["id"],"columns":[{"columnName":"id","typeName":"int","label":"id"}],"actions":[
+	{"actionName":"sleep","delay":"1s"},
+	{"actionName":"nap","actionType":"sleep","delay":"1ms"}]}]}`), molecule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return molecule
+	}
+	ctx := context.Background()
+
+	check := func(err error, name string, path ...string) {
+		t.Helper()
+		var godbiErr *Error
+		if !errors.Is(err, ErrTimeout) || !errors.Is(err, context.DeadlineExceeded) || !errors.As(err, &godbiErr) || godbiErr.Name != name {
+			t.Errorf("%v", err)
+		}
+		var pathErr *PathError
+		if !errors.As(err, &pathErr) || len(pathErr.Path) != len(path) {
+			t.Fatalf("%v", err)
+		}
+		for i, step := range path {
+			if pathErr.Path[i] != step {
+				t.Errorf("%v", err)
+			}
+		}
+	}
+
+	molecule := newMolecule()
+	// by the nextpage connection
+	_, err = molecule.RunContext(ctx, db, "m_a", "topics", nil)
+	check(err, "m_b.sleep", "m_a.topics", "m_b.sleep")
+
+	// by the action
+	_, err = molecule.RunContext(ctx, db, "m_a", "sleep", nil)
+	check(err, "m_a.sleep", "m_a.sleep")
+
+	// by the molecule
+	molecule.Timeout = "20ms"
+	_, err = molecule.RunContext(ctx, db, "m_b", "sleep", nil)
+	check(err, "molecule", "m_b.sleep")
+
+	lists, err := molecule.RunContext(ctx, db, "m_b", "nap", nil)
+	if err != nil || len(lists) != 1 {
+		t.Errorf("%v %#v", err, lists)
+	}
+
+	// by the caller, which is not a timeout of godbi
+	molecule.Timeout = ""
+	short, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err = molecule.RunContext(short, db, "m_b", "sleep", nil)
+	if errors.Is(err, ErrTimeout) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("%v", err)
+	}
+
+	// by the atom, in the molecule and alone
+	molecule = newMolecule()
+	molecule.GetAtom("m_b").Timeout = "20ms"
+	_, err = molecule.RunContext(ctx, db, "m_b", "sleep", nil)
+	check(err, "m_b", "m_b.sleep")
+	if _, err = molecule.GetAtom("m_b").RunAtomContext(ctx, db, "sleep", nil); !errors.Is(err, ErrTimeout) {
+		t.Errorf("%v", err)
+	}
+	lists, err = molecule.RunContext(ctx, db, "m_b", "nap", nil)
+	if err != nil || len(lists) != 1 {
+		t.Errorf("%v %#v", err, lists)
+	}
+
+	molecule = newMolecule()
+	molecule.GetAtom("m_a").GetAction("topics").GetBaseAction().Nextpages[0].Timeout = "soon"
+	if _, err = molecule.Compile(); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("%v", err)
+	}
+	molecule = newMolecule()
+	molecule.GetAtom("m_b").Timeout = "-1s"
+	if _, err = molecule.Compile(); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("%v", err)
+	}
+}
+
+func TestAtomTimeoutParse(t *testing.T) {
+	atom := new(Atom)
+	if err := json.Unmarshal([]byte(`{"atomName":"m_a","tableName":"m_a","timeout":"2s","actions":[{"actionName":"edit"}]}`), atom); err != nil {
+		t.Fatal(err)
+	}
+	if atom.Timeout != "2s" {
+		t.Errorf("%#v", atom)
+	}
+	bs, err := json.Marshal(atom)
+	if err != nil || !strings.Contains(string(bs), `"timeout":"2s"`) {
+		t.Errorf("%s %v", bs, err)
+	}
+
+	atom = new(Atom)
+	if err = atom.UnmarshalHCL([]byte(`tableName = "m_a"
+timeout = "500ms"
+actions edit {
+}
+`)); err != nil {
+		t.Fatal(err)
+	}
+	if atom.Timeout != "500ms" {
+		t.Errorf("%#v", atom)
+	}
+}
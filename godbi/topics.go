@@ -60,6 +60,14 @@ func (t *Topics) orderString(table *Table, args map[string]any, joints ...[]*Joi
 	column := ""
 	if args[nameSortby] != nil {
 		column = args[nameSortby].(string)
+		// the column comes from input, so it is checked before quoted
+		matched, err := regexp.MatchString("[;'\"`]", column)
+		if err != nil || matched {
+			return ""
+		}
+		if isIdent(column) {
+			column = table.quote(column)
+		}
 	} else if joints != nil {
 		j := joints[0][0]
 		if j.Sortby != "" {
@@ -73,7 +81,7 @@ func (t *Topics) orderString(table *Table, args map[string]any, joints ...[]*Joi
 			column = name + strings.Join(table.Pks, ", "+name)
 		}
 	} else {
-		column = strings.Join(table.Pks, ", ")
+		column = table.quoteList(table.Pks)
 	}
 
 	order := "ORDER BY " + column
@@ -105,14 +113,10 @@ func (t *Topics) orderString(table *Table, args map[string]any, joints ...[]*Joi
 			if pageno < 1 {
 				pageno = 1
 			}
-			order += " LIMIT " + strconv.Itoa(pagesize) + " OFFSET " + strconv.Itoa(pagesize*(pageno-1))
+			order += " " + table.GetDialect().Limit(pagesize, pagesize*(pageno-1))
 		}
 	}
 
-	matched, err := regexp.MatchString("[;'\"]", order)
-	if err != nil || matched {
-		return ""
-	}
 	return order
 }
 
@@ -168,42 +172,62 @@ func (t *Topics) pagination(ctx context.Context, db *sql.DB, table *Table, args
 	return nil
 }
 
+type pagingKey struct{}
+
+// withPaging returns ctx in which Topics reports its pagination to paging
+func withPaging(ctx context.Context, paging map[string]any) context.Context {
+	return context.WithValue(ctx, pagingKey{}, paging)
+}
+
+// reportPaging reports the pagination in args to the paging of ctx, if any
+func (t *Topics) reportPaging(ctx context.Context, args map[string]any) {
+	paging, ok := ctx.Value(pagingKey{}).(map[string]any)
+	if !ok {
+		return
+	}
+	for _, item := range []string{t.PAGENO, t.TOTALNO, t.MAXPAGENO} {
+		if v, ok := args[item]; ok {
+			paging[item] = v
+		}
+	}
+}
+
 func (t *Topics) RunAction(db *sql.DB, table *Table, args map[string]any, extra ...map[string]any) ([]any, error) {
 	return t.RunActionContext(context.Background(), db, table, args, extra...)
 }
 
 func (t *Topics) RunActionContext(ctx context.Context, db *sql.DB, table *Table, args map[string]any, extra ...map[string]any) ([]any, error) {
 	t.setDefaultElementNames()
-	sql, labels := table.filterPars(args, t.FIELDS, t.getAllowed())
+	// the pagination is written in a copy, not in the caller's args
+	args = cloneMap(args)
+	sql, labels := t.selectPars(table, args, t.FIELDS)
 	order := t.orderString(table, args)
 
 	err := t.pagination(ctx, db, table, args, extra...)
 	if err != nil {
 		return nil, err
 	}
+	t.reportPaging(ctx, args)
 
-	newExtra := table.byConstraint(args, extra...)
+	newExtra, err := table.coerceExtra(table.byConstraint(args, extra...))
+	if err != nil {
+		return nil, err
+	}
 	if hasValue(newExtra) {
-		where, values := selectCondition(newExtra, table.TableName)
+		where, values := table.selectCondition(newExtra[0], table.QualifiedName())
 		if where != "" {
 			sql += "\nWHERE " + where
 		}
 		if order != "" {
 			sql += "\n" + order
 		}
-		if table.dbDriver == Postgres {
-			sql = questionMarkerNumber(sql)
-		}
 
-		return getSQL(ctx, db, table.logger, sql, labels, values...)
+		return getSQL(ctx, table.dbi(db), table.GetDialect().Placeholder(sql), labels, values...)
 	}
 
 	if order != "" {
 		sql += "\n" + order
 	}
-	if table.dbDriver == Postgres {
-		sql = questionMarkerNumber(sql)
-	}
 
-	return getSQL(ctx, db, table.logger, sql, labels)
+	return getSQL(ctx, table.dbi(db), table.GetDialect().Placeholder(sql), labels)
 }
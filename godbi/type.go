@@ -8,6 +8,7 @@ const (
 	SQLite
 	MySQL
 	Postgres
+	SQLServer
 )
 
 // LowerName returns the lower case name of the DBType
@@ -19,6 +20,8 @@ func (d DBType) LowerName() string {
 		return "mysql"
 	case Postgres:
 		return "postgres"
+	case SQLServer:
+		return "sqlserver"
 	default:
 	}
 	return ""
@@ -33,6 +36,8 @@ func DBTypeByName(str string) DBType {
 		return MySQL
 	case "Postgres", "postgres", "PostgreSQL", "postgresql":
 		return Postgres
+	case "SQLServer", "sqlserver", "mssql", "MSSQL":
+		return SQLServer
 	default:
 	}
 	return SQLDefault
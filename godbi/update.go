@@ -9,6 +9,13 @@ import (
 type Update struct {
 	Action
 	Empties []string `json:"empties,omitempty" hcl:"empties,optional"`
+	// Returning: if true, return the whole persisted row
+	Returning bool `json:"returning,omitempty" hcl:"returning,optional"`
+	// ROWSAFFECTED: if set, the number of rows matched is reported
+	// in the output under this key
+	ROWSAFFECTED string `json:"rowsaffected,omitempty" hcl:"rowsaffected,optional"`
+	// Strict: if true, return ErrNotFound when no row is updated
+	Strict bool `json:"strict,omitempty" hcl:"strict,optional"`
 }
 
 var _ Capability = (*Update)(nil)
@@ -26,8 +33,19 @@ func (u *Update) RunActionContext(ctx context.Context, db *sql.DB, t *Table, arg
 	if !hasValue(ids) {
 		return nil, errorMissingPk(t.TableName)
 	}
+	ids, err := t.coerceIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	extra, err = t.coerceExtra(extra...)
+	if err != nil {
+		return nil, err
+	}
 
-	fieldValues, allAuto := t.getFv(args, u.getAllowed())
+	fieldValues, allAuto, err := t.getFv(args, u.getAllowed())
+	if err != nil {
+		return nil, err
+	}
 	if allAuto {
 		return fromFv(fieldValues), nil
 	}
@@ -39,9 +57,33 @@ func (u *Update) RunActionContext(ctx context.Context, db *sql.DB, t *Table, arg
 				return fromFv(fieldValues), nil
 			}
 		}
-		return fromFv(fieldValues), nil
 	}
 
-	err := t.updateHashNullsContext(ctx, db, fieldValues, ids, u.Empties, extra...)
-	return fromFv(fieldValues), err
+	var affected int64
+	if u.Returning {
+		fieldValues, err = t.updateReturningContext(ctx, db, fieldValues, ids, u.Empties, extra...)
+		if fieldValues != nil {
+			affected = 1
+		}
+	} else {
+		affected, err = t.updateHashNullsContext(ctx, db, fieldValues, ids, u.Empties, extra...)
+		if err == nil && affected == 0 && (u.Strict || u.ROWSAFFECTED != "") {
+			affected, err = t.matchedContext(ctx, db, ids, extra...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.Strict && affected == 0 {
+		return nil, errorNotFound(t.TableName)
+	}
+	if u.ROWSAFFECTED != "" {
+		if fieldValues == nil {
+			fieldValues = make(map[string]any)
+		}
+		fieldValues[u.ROWSAFFECTED] = affected
+	} else if fieldValues == nil {
+		return nil, nil
+	}
+	return fromFv(fieldValues), nil
 }
@@ -3,23 +3,56 @@ package godbi
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
 
 var reQuestion = regexp.MustCompile(`"[^"]*"|'[^']*(?:''[^']*)*'|\?`)
+var reQuestionBracket = regexp.MustCompile(`"[^"]*"|'[^']*(?:''[^']*)*'|\[[^\]]*\]|\?`)
 
 func questionMarkerNumber(query string) string {
+	return markerNumber(reQuestion, query, `$`)
+}
+
+// markerNumber replaces question marks, not quoted as matched by re,
+// with prefix followed by the sequence number
+func markerNumber(re *regexp.Regexp, query, prefix string) string {
 	i := 1
 	repl := func(in string) string {
 		if in == "?" {
-			x := `$` + strconv.Itoa(i)
+			x := prefix + strconv.Itoa(i)
 			i++
 			return x
 		}
 		return in
 	}
-	return reQuestion.ReplaceAllStringFunc(query, repl)
+	return re.ReplaceAllStringFunc(query, repl)
+}
+
+var reIdent = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*(\.[A-Za-z_][A-Za-z0-9_$]*)*$`)
+
+// isIdent tells if str is a simple, optionally dotted, identifier
+func isIdent(str string) bool {
+	return reIdent.MatchString(str)
+}
+
+// qualifiedName joins schema and name by dot, if schema is not empty
+func qualifiedName(schema, name string) string {
+	if schema == "" {
+		return name
+	}
+	return schema + "." + name
+}
+
+// sortedKeys returns the keys of map m in sorted order
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func hasValue(extra any) bool {
@@ -126,3 +126,32 @@ func TestMapUtils(t *testing.T) {
 		t.Errorf("identical: %t, keyFound: %t", identical, keyFound)
 	}
 }
+
+func TestQuoteIdent(t *testing.T) {
+	cases := []struct {
+		driver DBType
+		in     string
+		out    string
+	}{
+		{Postgres, "order", `"order"`},
+		{Postgres, "UserName", `"UserName"`},
+		{Postgres, "s.t", `"s"."t"`},
+		{Postgres, `"done"`, `"done"`},
+		{Postgres, `a"b`, `"a""b"`},
+		{SQLite, "group", `"group"`},
+		{MySQL, "order", "`order`"},
+		{MySQL, "t.*", "`t`.*"},
+		{MySQL, "*", "*"},
+		{SQLServer, "dbo.order", "[dbo].[order]"},
+		{SQLServer, "a]b", "[a]]b]"},
+		{SQLRaw, "order", "order"},
+	}
+	for _, c := range cases {
+		if got := c.driver.Dialect().Quote(c.in); got != c.out {
+			t.Errorf("%v %s => %s, %s wanted", c.driver, c.in, got, c.out)
+		}
+	}
+	if !isIdent("a.b_1") || isIdent("a;drop") || isIdent("1a") {
+		t.Errorf("isIdent failed")
+	}
+}
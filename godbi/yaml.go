@@ -0,0 +1,119 @@
+package godbi
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML returns the YAML encoding of v, which is a molecule, an atom,
+// an action or a connection. The field names are those of the JSON tags,
+// and ConnectType is written by name, e.g. one or many.
+func MarshalYAML(v any) ([]byte, error) {
+	node, err := yamlNode(v)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalYAML parses the YAML encoding of a molecule, an atom, an action
+// or a connection into v. Custom actions are decoded as in JSON.
+func UnmarshalYAML(bs []byte, v any) error {
+	var node yaml.Node
+	if err := yaml.Unmarshal(bs, &node); err != nil {
+		return err
+	}
+	return decodeYAML(&node, v)
+}
+
+// yamlNode converts v to YAML by its JSON encoding, which keeps the order
+// of fields
+func yamlNode(v any) (*yaml.Node, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	node := new(yaml.Node)
+	if err = yaml.Unmarshal(bs, node); err != nil {
+		return nil, err
+	}
+	blockStyle(node)
+	if node.Kind == yaml.DocumentNode && len(node.Content) == 1 {
+		node = node.Content[0]
+	}
+	return node, nil
+}
+
+// blockStyle drops the JSON flow style and quotes, and names ConnectType.
+// Strings looking like other types are still quoted by the encoder.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "dimension" && value.Kind == yaml.ScalarNode {
+				var c ConnectType
+				if err := value.Decode(&c); err == nil {
+					value.SetString(c.String())
+				}
+			}
+		}
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// decodeYAML decodes node into v by JSON
+func decodeYAML(node *yaml.Node, v any) error {
+	var tree any
+	if err := node.Decode(&tree); err != nil {
+		return err
+	}
+	bs, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
+
+// MarshalYAML is a YAML marshaler
+func (m *Molecule) MarshalYAML() (any, error) {
+	return yamlNode(m)
+}
+
+// UnmarshalYAML is a YAML unmarshaler
+func (m *Molecule) UnmarshalYAML(node *yaml.Node) error {
+	return decodeYAML(node, m)
+}
+
+// MarshalYAML is a YAML marshaler
+func (a *Atom) MarshalYAML() (any, error) {
+	return yamlNode(a)
+}
+
+// UnmarshalYAML is a YAML unmarshaler
+func (a *Atom) UnmarshalYAML(node *yaml.Node) error {
+	return decodeYAML(node, a)
+}
+
+// MarshalYAML is a YAML marshaler
+func (c *Connection) MarshalYAML() (any, error) {
+	return yamlNode(c)
+}
+
+// UnmarshalYAML is a YAML unmarshaler
+func (c *Connection) UnmarshalYAML(node *yaml.Node) error {
+	return decodeYAML(node, c)
+}
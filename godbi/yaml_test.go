@@ -0,0 +1,99 @@
+package godbi
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAMLMolecule(t *testing.T) {
+	dat, err := os.ReadFile("molecule.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := new(Molecule)
+	if err = json.Unmarshal(dat, m); err != nil {
+		t.Fatal(err)
+	}
+	m.Atoms[0].Actions = append(m.Atoms[0].Actions,
+		&counter{Action: Action{ActionName: "count", Nextpages: []*Connection{{AtomName: "m_b", ActionName: "topics", Dimension: CONNECTMany, Marker: "1"}}}, Column: "x"})
+
+	bs, err := MarshalYAML(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	str := string(bs)
+	if !strings.HasPrefix(str, "atoms:\n") || !strings.Contains(str, "dimension: many") || !strings.Contains(str, `marker: "1"`) {
+		t.Errorf("%s", bs)
+	}
+
+	m2 := new(Molecule)
+	if err = UnmarshalYAML(bs, m2); err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := json.Marshal(m)
+	got, _ := json.Marshal(m2)
+	if string(got) != string(expected) {
+		t.Errorf("%s\n%s", got, expected)
+	}
+	if c, ok := m2.Atoms[0].GetAction("count").(*counter); !ok || c.Nextpages[0].Dimension != CONNECTMany {
+		t.Errorf("%#v", m2.Atoms[0].Actions)
+	}
+}
+
+func TestYAMLEmbedded(t *testing.T) {
+	config := struct {
+		Name     string    `yaml:"name"`
+		Molecule *Molecule `yaml:"molecule"`
+	}{}
+	err := yaml.Unmarshal([]byte(`
+name: sales
+molecule:
+  dbDriver: 2
+  atoms:
+    - atomName: m_a
+      tableName: m_a
+      pks: [id]
+      columns:
+        - {columnName: id, typeName: int, label: id}
+        - {columnName: x, typeName: string, label: x}
+      actions:
+        - actionName: listX
+          actionType: topics
+          picked: [x]
+          nextpages:
+            - atomName: m_b
+              actionName: topics
+              relateExtra: {id: id}
+              dimension: one
+        - actionName: count
+          column: x
+`), &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	atom := config.Molecule.GetAtom("m_a")
+	topics, ok := atom.GetAction("listX").(*Topics)
+	if !ok || topics.Nextpages[0].Dimension != CONNECTOne {
+		t.Fatalf("%#v", atom.Actions)
+	}
+	if _, ok := atom.GetAction("count").(*counter); !ok {
+		t.Errorf("%#v", atom.Actions)
+	}
+
+	bs, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(bs), "actionType: topics") || !strings.Contains(string(bs), "dimension: one") {
+		t.Errorf("%s", bs)
+	}
+
+	c := new(Connection)
+	if err = UnmarshalYAML([]byte("atomName: m_b\nactionName: edit\ndimension: sideways\n"), c); err == nil {
+		t.Errorf("unknown dimension accepted")
+	}
+}
@@ -1,17 +1,37 @@
 package gometa
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/genelet/molecule/godbi"
 )
 
 // GraphToMolecule translates protobuf message into molecule with possible oneof map
 //
 // oneof format: map[atomName][oneofName][list of fields]
+//
+// The molecule is nil if the graph can not be translated, e.g. by a custom
+// action of an unregistered type. Use GraphToMoleculeE to get the error.
 func GraphToMolecule(graph *Graph) (*godbi.Molecule, map[string]map[string][]string) {
+	molecule, oneofs, err := GraphToMoleculeE(graph)
+	if err != nil {
+		return nil, nil
+	}
+	return molecule, oneofs
+}
+
+// GraphToMoleculeE is the same as GraphToMolecule, but returns an error if
+// a custom action is of an unregistered type, or its body does not fit the
+// type.
+func GraphToMoleculeE(graph *Graph) (*godbi.Molecule, map[string]map[string][]string, error) {
 	var atoms []*godbi.Atom
 	var oneofs map[string]map[string][]string
 	for _, node := range graph.Nodes {
-		atom, hash := nodeToAtom(node)
+		atom, hash, err := nodeToAtom(node)
+		if err != nil {
+			return nil, nil, err
+		}
 		atoms = append(atoms, atom)
 		if hash != nil {
 			if oneofs == nil {
@@ -20,18 +40,22 @@ func GraphToMolecule(graph *Graph) (*godbi.Molecule, map[string]map[string][]str
 			oneofs[node.AtomTable.TableName] = hash
 		}
 	}
-	return &godbi.Molecule{Atoms: atoms, DBDriver: godbi.DBType(graph.DBDriver)}, oneofs
+	return &godbi.Molecule{Atoms: atoms, DBDriver: godbi.DBType(graph.DBDriver), Dialect: graph.Dialect}, oneofs, nil
 }
 
-func nodeToAtom(node *Node) (*godbi.Atom, map[string][]string) {
+func nodeToAtom(node *Node) (*godbi.Atom, map[string][]string, error) {
 	atomTable, oneofs := nodeTableToAtomTable(node.AtomTable)
-	atomActions := nodeActionsToAtomActions(node.AtomActions)
-	return &godbi.Atom{AtomName: node.AtomName, Table: *atomTable, Actions: atomActions}, oneofs
+	atomActions, err := nodeActionsToAtomActions(node.AtomActions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("atom %s: %w", node.AtomName, err)
+	}
+	return &godbi.Atom{AtomName: node.AtomName, Table: *atomTable, Actions: atomActions}, oneofs, nil
 }
 
 func nodeTableToAtomTable(nodeTable *Node_Table) (*godbi.Table, map[string][]string) {
 	atomTable := &godbi.Table{}
 	atomTable.TableName = nodeTable.GetTableName()
+	atomTable.Schema = nodeTable.GetSchema()
 	atomTable.Pks = nodeTable.GetPks()
 	atomTable.IDAuto = nodeTable.GetIDAuto()
 	atomTable.Uniques = nodeTable.GetUniques()
@@ -61,16 +85,19 @@ func nodeTableToAtomTable(nodeTable *Node_Table) (*godbi.Table, map[string][]str
 	}
 	for _, fk := range nodeTable.GetFks() {
 		atomFk := &godbi.Fk{
-			FkTable:  fk.GetFkTable(),
-			FkColumn: fk.GetFkColumn(),
-			Column:   fk.GetColumn()}
+			FkTable:   fk.GetFkTable(),
+			FkSchema:  fk.GetFkSchema(),
+			FkColumn:  fk.GetFkColumn(),
+			Column:    fk.GetColumn(),
+			FkColumns: fk.GetFkColumns(),
+			Columns:   fk.GetColumns()}
 		atomTable.Fks = append(atomTable.Fks, atomFk)
 	}
 
 	return atomTable, oneofs
 }
 
-func nodeActionsToAtomActions(nodeActions *Node_Actions) []godbi.Capability {
+func nodeActionsToAtomActions(nodeActions *Node_Actions) ([]godbi.Capability, error) {
 	var actions []godbi.Capability
 
 	dbiConnection := func(conn *Node_Actions_Connection) *godbi.Connection {
@@ -181,5 +208,22 @@ func nodeActionsToAtomActions(nodeActions *Node_Actions) []godbi.Capability {
 		actions = append(actions, atomEdit)
 	}
 
-	return actions
+	for _, custom := range nodeActions.GetCustomItems() {
+		actionType := custom.GetActionType()
+		if actionType == "" {
+			actionType = custom.GetActionName()
+		}
+		action := godbi.NewCapability(actionType)
+		if action == nil {
+			return nil, fmt.Errorf("custom action %s of type %s not registered", custom.GetActionName(), actionType)
+		}
+		if err := json.Unmarshal(custom.GetBody(), action); err != nil {
+			return nil, fmt.Errorf("custom action %s of type %s: %w", custom.GetActionName(), actionType, err)
+		}
+		action.GetBaseAction().ActionName = custom.GetActionName()
+		action.GetBaseAction().IsDo = custom.GetIsDo()
+		actions = append(actions, action)
+	}
+
+	return actions, nil
 }
@@ -152,6 +152,7 @@ type Graph struct {
 	PksTable      map[string]string `protobuf:"bytes,6,rep,name=pksTable,proto3" json:"pksTable,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Pks           map[string]string `protobuf:"bytes,7,rep,name=pks,proto3" json:"pks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Nodes         []*Node           `protobuf:"bytes,8,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Dialect       string            `protobuf:"bytes,9,opt,name=dialect,proto3" json:"dialect,omitempty"`
 }
 
 func (x *Graph) Reset() {
@@ -242,6 +243,13 @@ func (x *Graph) GetNodes() []*Node {
 	return nil
 }
 
+func (x *Graph) GetDialect() string {
+	if x != nil {
+		return x.Dialect
+	}
+	return ""
+}
+
 type Node_Table struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -253,6 +261,7 @@ type Node_Table struct {
 	IDAuto    string            `protobuf:"bytes,4,opt,name=idAuto,proto3" json:"idAuto,omitempty"`
 	Fks       []*Node_Table_Fk  `protobuf:"bytes,5,rep,name=fks,proto3" json:"fks,omitempty"`
 	Uniques   []string          `protobuf:"bytes,6,rep,name=uniques,proto3" json:"uniques,omitempty"`
+	Schema    string            `protobuf:"bytes,7,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
 func (x *Node_Table) Reset() {
@@ -329,18 +338,26 @@ func (x *Node_Table) GetUniques() []string {
 	return nil
 }
 
+func (x *Node_Table) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
 type Node_Actions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InsertItem *Node_Actions_Insert `protobuf:"bytes,7,opt,name=insertItem,proto3" json:"insertItem,omitempty"`
-	UpdateItem *Node_Actions_Update `protobuf:"bytes,9,opt,name=updateItem,proto3" json:"updateItem,omitempty"`
-	InsupdItem *Node_Actions_Insupd `protobuf:"bytes,10,opt,name=insupdItem,proto3" json:"insupdItem,omitempty"`
-	DeleteItem *Node_Actions_Delete `protobuf:"bytes,12,opt,name=deleteItem,proto3" json:"deleteItem,omitempty"`
-	DelecsItem *Node_Actions_Delecs `protobuf:"bytes,13,opt,name=delecsItem,proto3" json:"delecsItem,omitempty"`
-	EditItem   *Node_Actions_Edit   `protobuf:"bytes,14,opt,name=editItem,proto3" json:"editItem,omitempty"`
-	TopicsItem *Node_Actions_Topics `protobuf:"bytes,15,opt,name=topicsItem,proto3" json:"topicsItem,omitempty"`
+	InsertItem  *Node_Actions_Insert   `protobuf:"bytes,7,opt,name=insertItem,proto3" json:"insertItem,omitempty"`
+	UpdateItem  *Node_Actions_Update   `protobuf:"bytes,9,opt,name=updateItem,proto3" json:"updateItem,omitempty"`
+	InsupdItem  *Node_Actions_Insupd   `protobuf:"bytes,10,opt,name=insupdItem,proto3" json:"insupdItem,omitempty"`
+	DeleteItem  *Node_Actions_Delete   `protobuf:"bytes,12,opt,name=deleteItem,proto3" json:"deleteItem,omitempty"`
+	DelecsItem  *Node_Actions_Delecs   `protobuf:"bytes,13,opt,name=delecsItem,proto3" json:"delecsItem,omitempty"`
+	EditItem    *Node_Actions_Edit     `protobuf:"bytes,14,opt,name=editItem,proto3" json:"editItem,omitempty"`
+	TopicsItem  *Node_Actions_Topics   `protobuf:"bytes,15,opt,name=topicsItem,proto3" json:"topicsItem,omitempty"`
+	CustomItems []*Node_Actions_Custom `protobuf:"bytes,16,rep,name=customItems,proto3" json:"customItems,omitempty"`
 }
 
 func (x *Node_Actions) Reset() {
@@ -424,6 +441,13 @@ func (x *Node_Actions) GetTopicsItem() *Node_Actions_Topics {
 	return nil
 }
 
+func (x *Node_Actions) GetCustomItems() []*Node_Actions_Custom {
+	if x != nil {
+		return x.CustomItems
+	}
+	return nil
+}
+
 type Node_Table_Col struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -532,9 +556,12 @@ type Node_Table_Fk struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FkTable  string `protobuf:"bytes,1,opt,name=fkTable,proto3" json:"fkTable,omitempty"`
-	FkColumn string `protobuf:"bytes,2,opt,name=fkColumn,proto3" json:"fkColumn,omitempty"`
-	Column   string `protobuf:"bytes,3,opt,name=column,proto3" json:"column,omitempty"`
+	FkTable   string   `protobuf:"bytes,1,opt,name=fkTable,proto3" json:"fkTable,omitempty"`
+	FkColumn  string   `protobuf:"bytes,2,opt,name=fkColumn,proto3" json:"fkColumn,omitempty"`
+	Column    string   `protobuf:"bytes,3,opt,name=column,proto3" json:"column,omitempty"`
+	FkColumns []string `protobuf:"bytes,4,rep,name=fkColumns,proto3" json:"fkColumns,omitempty"`
+	Columns   []string `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	FkSchema  string   `protobuf:"bytes,6,opt,name=fkSchema,proto3" json:"fkSchema,omitempty"`
 }
 
 func (x *Node_Table_Fk) Reset() {
@@ -590,6 +617,27 @@ func (x *Node_Table_Fk) GetColumn() string {
 	return ""
 }
 
+func (x *Node_Table_Fk) GetFkColumns() []string {
+	if x != nil {
+		return x.FkColumns
+	}
+	return nil
+}
+
+func (x *Node_Table_Fk) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Node_Table_Fk) GetFkSchema() string {
+	if x != nil {
+		return x.FkSchema
+	}
+	return ""
+}
+
 type Node_Actions_Connection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1381,11 +1429,84 @@ func (x *Node_Actions_Topics) GetFIELDS() string {
 	return ""
 }
 
+// custom action registered by godbi.RegisterCapability, or another
+// instance of a built-in type, in JSON
+type Node_Actions_Custom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionName string `protobuf:"bytes,1,opt,name=actionName,proto3" json:"actionName,omitempty"`
+	IsDo       bool   `protobuf:"varint,2,opt,name=isDo,proto3" json:"isDo,omitempty"`
+	Body       []byte `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	ActionType string `protobuf:"bytes,4,opt,name=actionType,proto3" json:"actionType,omitempty"`
+}
+
+func (x *Node_Actions_Custom) Reset() {
+	*x = Node_Actions_Custom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_meta_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node_Actions_Custom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node_Actions_Custom) ProtoMessage() {}
+
+func (x *Node_Actions_Custom) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meta_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node_Actions_Custom.ProtoReflect.Descriptor instead.
+func (*Node_Actions_Custom) Descriptor() ([]byte, []int) {
+	return file_proto_meta_proto_rawDescGZIP(), []int{0, 1, 9}
+}
+
+func (x *Node_Actions_Custom) GetActionName() string {
+	if x != nil {
+		return x.ActionName
+	}
+	return ""
+}
+
+func (x *Node_Actions_Custom) GetIsDo() bool {
+	if x != nil {
+		return x.IsDo
+	}
+	return false
+}
+
+func (x *Node_Actions_Custom) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Node_Actions_Custom) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
 var File_proto_meta_proto protoreflect.FileDescriptor
 
 var file_proto_meta_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x08, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x22, 0xa8, 0x1d, 0x0a,
+	0x74, 0x6f, 0x12, 0x08, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x22, 0xc8, 0x1f, 0x0a,
 	0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x6f, 0x6d, 0x4e, 0x61, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74, 0x6f, 0x6d, 0x4e, 0x61, 0x6d,
 	0x65, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x74, 0x6f, 0x6d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02,
@@ -1395,7 +1516,7 @@ var file_proto_meta_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6c,
 	0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x52, 0x0b, 0x61, 0x74, 0x6f, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0xf8, 0x03, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62,
+	0xe5, 0x04, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62,
 	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
 	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
 	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63,
@@ -1407,144 +1528,126 @@ var file_proto_meta_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f,
 	0x64, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x46, 0x6b, 0x52, 0x03, 0x66, 0x6b, 0x73,
 	0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x73, 0x1a, 0xd9, 0x01, 0x0a, 0x03, 0x43,
-	0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x6e, 0x75, 0x6c, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x6e, 0x75, 0x6c, 0x6c, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75,
-	0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x69, 0x6e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
-	0x6e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x1a, 0x52, 0x0a, 0x02, 0x46, 0x6b, 0x12, 0x18, 0x0a, 0x07,
-	0x66, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66,
-	0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6b, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6b, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x1a, 0x9a, 0x18, 0x0a, 0x07, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c,
-	0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x65, 0x72,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65,
-	0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x49, 0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x75, 0x70, 0x64, 0x49, 0x74,
-	0x65, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63,
-	0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x49, 0x6e, 0x73, 0x75, 0x70, 0x64, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x75, 0x70, 0x64, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75,
+	0x09, 0x52, 0x07, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x1a, 0xd9, 0x01, 0x0a, 0x03, 0x43, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x6f, 0x74, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e,
+	0x6f, 0x74, 0x6e, 0x75, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x1a, 0xa6,
+	0x01, 0x0a, 0x02, 0x46, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x6b, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x6b, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6b, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x6b, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x1a, 0xcd, 0x19, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75,
 	0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74,
-	0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c,
-	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x63, 0x73, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x63, 0x73, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x37, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x64, 0x69, 0x74,
-	0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0a, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0xca, 0x03, 0x0a, 0x0a, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x6f, 0x6d,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74, 0x6f, 0x6d,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72,
-	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63,
-	0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x61,
-	0x74, 0x65, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6d,
-	0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63,
-	0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x64, 0x69,
-	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x61, 0x74,
-	0x65, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xf0, 0x01, 0x0a, 0x06, 0x49, 0x6e, 0x73, 0x65, 0x72,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c,
-	0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4d,
-	0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63,
-	0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78,
-	0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x69, 0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0x8a, 0x02, 0x0a, 0x06, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c,
+	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x75, 0x70, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x49, 0x6e,
+	0x73, 0x75, 0x70, 0x64, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x75, 0x70, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x3d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x63, 0x73, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x37,
+	0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x08, 0x65,
+	0x64, 0x69, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f,
 	0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10,
-	0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x69, 0x73, 0x44, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0xf0, 0x01, 0x0a, 0x06, 0x49, 0x6e, 0x73, 0x75, 0x70,
-	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c,
+	0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f,
+	0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xca, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x6f, 0x6d, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74, 0x6f, 0x6d, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c,
+	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x6c,
 	0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4d,
-	0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63,
+	0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c,
+	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0xf0, 0x01, 0x0a, 0x06, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63,
 	0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78,
-	0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x69, 0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0xd8, 0x01, 0x0a, 0x06, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f,
-	0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x10,
 	0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x69, 0x73, 0x44, 0x6f, 0x1a, 0xd8, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x63, 0x73, 0x12,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c,
+	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0x8a, 0x02, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f,
+	0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x4d, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65,
+	0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65,
+	0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73,
+	0x44, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x69,
+	0x63, 0x6b, 0x65, 0x64, 0x1a, 0xf0, 0x01, 0x0a, 0x06, 0x49, 0x6e, 0x73, 0x75, 0x70, 0x64, 0x12,
 	0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
 	0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
@@ -1557,70 +1660,106 @@ var file_proto_meta_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43,
 	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70,
 	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x1a,
-	0xa5, 0x01, 0x0a, 0x05, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x69,
-	0x6e, 0x55, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f,
-	0x69, 0x6e, 0x55, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x4f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x4f, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x6f, 0x72, 0x74, 0x62, 0x79, 0x1a, 0x86, 0x02, 0x0a, 0x04, 0x45, 0x64, 0x69, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65,
+	0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0xd8, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f,
+	0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x4d, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65,
 	0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70, 0x72,
-	0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4d, 0x0a,
-	0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75,
-	0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74,
-	0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x69, 0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x49, 0x45, 0x4c,
-	0x44, 0x53, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x53,
-	0x1a, 0xce, 0x03, 0x0a, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65,
+	0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73,
+	0x44, 0x6f, 0x1a, 0xd8, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x63, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a,
+	0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c,
+	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6e, 0x65,
+	0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e,
 	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74,
-	0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x44,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x1a, 0xa5, 0x01,
+	0x0a, 0x05, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6a,
+	0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a,
+	0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x55,
+	0x73, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e,
+	0x55, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x4f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x4f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x62, 0x79, 0x1a, 0x86, 0x02, 0x0a, 0x04, 0x45, 0x64, 0x69, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75,
+	0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6e,
+	0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73,
+	0x44, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x53,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x53, 0x1a, 0xce,
+	0x03, 0x0a, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f,
 	0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x69, 0x63,
-	0x6b, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x41, 0x58, 0x50, 0x41, 0x47, 0x45, 0x4e, 0x4f,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x41, 0x58, 0x50, 0x41, 0x47, 0x45, 0x4e,
-	0x4f, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x4e, 0x4f, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x4e, 0x4f, 0x12, 0x1a, 0x0a, 0x08, 0x50,
-	0x41, 0x47, 0x45, 0x53, 0x49, 0x5a, 0x45, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50,
-	0x41, 0x47, 0x45, 0x53, 0x49, 0x5a, 0x45, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x41, 0x47, 0x45, 0x4e,
-	0x4f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x41, 0x47, 0x45, 0x4e, 0x4f, 0x12,
-	0x16, 0x0a, 0x06, 0x53, 0x4f, 0x52, 0x54, 0x42, 0x59, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x53, 0x4f, 0x52, 0x54, 0x42, 0x59, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x4f, 0x52, 0x54, 0x52,
-	0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x4f,
-	0x52, 0x54, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x49, 0x45,
-	0x4c, 0x44, 0x53, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x49, 0x45, 0x4c, 0x44,
-	0x53, 0x22, 0x64, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x41, 0x58, 0x50, 0x41, 0x47, 0x45, 0x4e, 0x4f, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x41, 0x58, 0x50, 0x41, 0x47, 0x45, 0x4e, 0x4f, 0x12,
+	0x18, 0x0a, 0x07, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x4e, 0x4f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x4e, 0x4f, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x41, 0x47,
+	0x45, 0x53, 0x49, 0x5a, 0x45, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x41, 0x47,
+	0x45, 0x53, 0x49, 0x5a, 0x45, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x41, 0x47, 0x45, 0x4e, 0x4f, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x41, 0x47, 0x45, 0x4e, 0x4f, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x4f, 0x52, 0x54, 0x42, 0x59, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
+	0x4f, 0x52, 0x54, 0x42, 0x59, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x4f, 0x52, 0x54, 0x52, 0x45, 0x56,
+	0x45, 0x52, 0x53, 0x45, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x4f, 0x52, 0x54,
+	0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x53, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x53, 0x1a,
+	0x70, 0x0a, 0x06, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x44,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x44, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x64, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x44, 0x65, 0x66, 0x61, 0x75,
 	0x6c, 0x74, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f,
 	0x6e, 0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x41,
 	0x72, 0x72, 0x61, 0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
 	0x54, 0x4d, 0x61, 0x70, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
-	0x54, 0x4d, 0x61, 0x6e, 0x79, 0x10, 0x04, 0x22, 0x9f, 0x03, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70,
+	0x54, 0x4d, 0x61, 0x6e, 0x79, 0x10, 0x04, 0x22, 0xb9, 0x03, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70,
 	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e,
 	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
@@ -1639,15 +1778,17 @@ var file_proto_meta_proto_rawDesc = []byte{
 	0x2e, 0x50, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x70, 0x6b, 0x73, 0x12, 0x24,
 	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
 	0x6d, 0x6f, 0x6c, 0x65, 0x63, 0x75, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e,
-	0x6f, 0x64, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x50, 0x6b, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x36, 0x0a, 0x08, 0x50, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x67,
-	0x6f, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x50, 0x6b, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x50,
+	0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x67, 0x6f, 0x6d, 0x65, 0x74, 0x61, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1663,7 +1804,7 @@ func file_proto_meta_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_meta_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_meta_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_proto_meta_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_proto_meta_proto_goTypes = []any{
 	(Node_Actions_ConnectType)(0),   // 0: molecule.Node.Actions.ConnectType
 	(*Node)(nil),                    // 1: molecule.Node
@@ -1681,16 +1822,17 @@ var file_proto_meta_proto_goTypes = []any{
 	(*Node_Actions_Joint)(nil),      // 13: molecule.Node.Actions.Joint
 	(*Node_Actions_Edit)(nil),       // 14: molecule.Node.Actions.Edit
 	(*Node_Actions_Topics)(nil),     // 15: molecule.Node.Actions.Topics
-	nil,                             // 16: molecule.Node.Actions.Connection.RelateArgsEntry
-	nil,                             // 17: molecule.Node.Actions.Connection.RelateExtraEntry
-	nil,                             // 18: molecule.Graph.PksTableEntry
-	nil,                             // 19: molecule.Graph.PksEntry
+	(*Node_Actions_Custom)(nil),     // 16: molecule.Node.Actions.Custom
+	nil,                             // 17: molecule.Node.Actions.Connection.RelateArgsEntry
+	nil,                             // 18: molecule.Node.Actions.Connection.RelateExtraEntry
+	nil,                             // 19: molecule.Graph.PksTableEntry
+	nil,                             // 20: molecule.Graph.PksEntry
 }
 var file_proto_meta_proto_depIdxs = []int32{
 	3,  // 0: molecule.Node.atomTable:type_name -> molecule.Node.Table
 	4,  // 1: molecule.Node.atomActions:type_name -> molecule.Node.Actions
-	18, // 2: molecule.Graph.pksTable:type_name -> molecule.Graph.PksTableEntry
-	19, // 3: molecule.Graph.pks:type_name -> molecule.Graph.PksEntry
+	19, // 2: molecule.Graph.pksTable:type_name -> molecule.Graph.PksTableEntry
+	20, // 3: molecule.Graph.pks:type_name -> molecule.Graph.PksEntry
 	1,  // 4: molecule.Graph.nodes:type_name -> molecule.Node
 	5,  // 5: molecule.Node.Table.columns:type_name -> molecule.Node.Table.Col
 	6,  // 6: molecule.Node.Table.fks:type_name -> molecule.Node.Table.Fk
@@ -1701,28 +1843,29 @@ var file_proto_meta_proto_depIdxs = []int32{
 	12, // 11: molecule.Node.Actions.delecsItem:type_name -> molecule.Node.Actions.Delecs
 	14, // 12: molecule.Node.Actions.editItem:type_name -> molecule.Node.Actions.Edit
 	15, // 13: molecule.Node.Actions.topicsItem:type_name -> molecule.Node.Actions.Topics
-	16, // 14: molecule.Node.Actions.Connection.relateArgs:type_name -> molecule.Node.Actions.Connection.RelateArgsEntry
-	17, // 15: molecule.Node.Actions.Connection.relateExtra:type_name -> molecule.Node.Actions.Connection.RelateExtraEntry
-	0,  // 16: molecule.Node.Actions.Connection.dimension:type_name -> molecule.Node.Actions.ConnectType
-	7,  // 17: molecule.Node.Actions.Insert.prepareConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 18: molecule.Node.Actions.Insert.nextpageConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 19: molecule.Node.Actions.Update.prepareConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 20: molecule.Node.Actions.Update.nextpageConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 21: molecule.Node.Actions.Insupd.prepareConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 22: molecule.Node.Actions.Insupd.nextpageConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 23: molecule.Node.Actions.Delete.prepareConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 24: molecule.Node.Actions.Delete.nextpageConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 25: molecule.Node.Actions.Delecs.prepareConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 26: molecule.Node.Actions.Delecs.nextpageConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 27: molecule.Node.Actions.Edit.prepareConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 28: molecule.Node.Actions.Edit.nextpageConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 29: molecule.Node.Actions.Topics.prepareConnects:type_name -> molecule.Node.Actions.Connection
-	7,  // 30: molecule.Node.Actions.Topics.nextpageConnects:type_name -> molecule.Node.Actions.Connection
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	16, // 14: molecule.Node.Actions.customItems:type_name -> molecule.Node.Actions.Custom
+	17, // 15: molecule.Node.Actions.Connection.relateArgs:type_name -> molecule.Node.Actions.Connection.RelateArgsEntry
+	18, // 16: molecule.Node.Actions.Connection.relateExtra:type_name -> molecule.Node.Actions.Connection.RelateExtraEntry
+	0,  // 17: molecule.Node.Actions.Connection.dimension:type_name -> molecule.Node.Actions.ConnectType
+	7,  // 18: molecule.Node.Actions.Insert.prepareConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 19: molecule.Node.Actions.Insert.nextpageConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 20: molecule.Node.Actions.Update.prepareConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 21: molecule.Node.Actions.Update.nextpageConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 22: molecule.Node.Actions.Insupd.prepareConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 23: molecule.Node.Actions.Insupd.nextpageConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 24: molecule.Node.Actions.Delete.prepareConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 25: molecule.Node.Actions.Delete.nextpageConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 26: molecule.Node.Actions.Delecs.prepareConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 27: molecule.Node.Actions.Delecs.nextpageConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 28: molecule.Node.Actions.Edit.prepareConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 29: molecule.Node.Actions.Edit.nextpageConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 30: molecule.Node.Actions.Topics.prepareConnects:type_name -> molecule.Node.Actions.Connection
+	7,  // 31: molecule.Node.Actions.Topics.nextpageConnects:type_name -> molecule.Node.Actions.Connection
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_proto_meta_proto_init() }
@@ -1911,6 +2054,18 @@ func file_proto_meta_proto_init() {
 				return nil
 			}
 		}
+		file_proto_meta_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Node_Actions_Custom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1918,7 +2073,7 @@ func file_proto_meta_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_meta_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
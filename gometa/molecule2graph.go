@@ -1,6 +1,8 @@
 package gometa
 
 import (
+	"encoding/json"
+
 	"github.com/genelet/molecule/godbi"
 )
 
@@ -51,7 +53,7 @@ func MoleculeToGraph(molecule *godbi.Molecule, args ...any) *Graph {
 		nodes = append(nodes, node)
 	}
 
-	return &Graph{PackageName: packageName, PkTable: pkTable, PkName: pkName, GoPackageName: goPackageName, DBDriver: int32(molecule.DBDriver), PksTable: pksTable, Pks: pks, Nodes: nodes}
+	return &Graph{PackageName: packageName, PkTable: pkTable, PkName: pkName, GoPackageName: goPackageName, DBDriver: int32(molecule.DBDriver), Dialect: molecule.Dialect, PksTable: pksTable, Pks: pks, Nodes: nodes}
 }
 
 func atomToNode(atom *godbi.Atom, oneofs ...map[string][]string) *Node {
@@ -78,6 +80,7 @@ func atomTableToNodeTable(table godbi.Table, oneofs ...map[string][]string) *Nod
 	nodeTable := &Node_Table{}
 
 	nodeTable.TableName = table.TableName
+	nodeTable.Schema = table.Schema
 	for _, col := range table.Columns {
 		nodeCol := &Node_Table_Col{
 			ColumnName: col.ColumnName,
@@ -94,9 +97,12 @@ func atomTableToNodeTable(table godbi.Table, oneofs ...map[string][]string) *Nod
 	nodeTable.IDAuto = table.IDAuto
 	for _, fk := range table.Fks {
 		nodeFk := &Node_Table_Fk{
-			FkTable:  fk.FkTable,
-			FkColumn: fk.FkColumn,
-			Column:   fk.Column}
+			FkTable:   fk.FkTable,
+			FkSchema:  fk.FkSchema,
+			FkColumn:  fk.FkColumn,
+			Column:    fk.Column,
+			FkColumns: fk.FkColumns,
+			Columns:   fk.Columns}
 		nodeTable.Fks = append(nodeTable.Fks, nodeFk)
 	}
 	nodeTable.Uniques = table.Uniques
@@ -222,5 +228,30 @@ func atomActionsToNodeActions(atom *godbi.Atom) *Node_Actions {
 		nodeActions.EditItem = nodeEdit
 	}
 
+	// the built-in actions by their default names are translated above
+	for _, action := range atom.Actions {
+		name := action.GetBaseAction().ActionName
+		actionType := godbi.ActionTypeOf(action)
+		switch action.(type) {
+		case *godbi.Insert, *godbi.Update, *godbi.Insupd, *godbi.Delete, *godbi.Delecs, *godbi.Topics, *godbi.Edit, *godbi.Stmt:
+			if name == actionType {
+				continue
+			}
+		default:
+		}
+		body, err := json.Marshal(action)
+		if err != nil {
+			continue
+		}
+		nodeCustom := &Node_Actions_Custom{
+			ActionName: name,
+			IsDo:       action.GetBaseAction().IsDo,
+			Body:       body}
+		if name != actionType {
+			nodeCustom.ActionType = actionType
+		}
+		nodeActions.CustomItems = append(nodeActions.CustomItems, nodeCustom)
+	}
+
 	return nodeActions
 }
@@ -1,8 +1,11 @@
 package gometa
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/genelet/molecule/godbi"
@@ -11,7 +14,10 @@ import (
 
 func tryit(m *godbi.Molecule, t *testing.T) {
 	g := MoleculeToGraph(m, nil, "gometa", "Graph_id")
-	m1, oneofs := GraphToMolecule(g)
+	m1, oneofs, err := GraphToMoleculeE(g)
+	if err != nil {
+		t.Fatal(err)
+	}
 	g1 := MoleculeToGraph(m1, oneofs, "gometa", "Graph_id")
 	if !proto.Equal(g, g1) {
 		if g.PackageName != g1.PackageName {
@@ -76,3 +82,77 @@ func TestMolecule2Graph(t *testing.T) {
 		tryit(molecule, t)
 	}
 }
+
+type counter struct {
+	godbi.Action
+	Column string `json:"column,omitempty"`
+}
+
+func (c *counter) RunActionContext(ctx context.Context, db *sql.DB, t *godbi.Table, args map[string]any, extra ...map[string]any) ([]any, error) {
+	return nil, nil
+}
+
+func TestGraphCustom(t *testing.T) {
+	godbi.RegisterCapability("count", &counter{})
+
+	atom := new(godbi.Atom)
+	err := json.Unmarshal([]byte(`{"atomName":"m_a","tableName":"m_a","pks":["id"],"actions":[{"actionName":"count","column":"x","nextpages":[{"atomName":"m_b","actionName":"topics"}]}]}`), atom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &godbi.Molecule{Atoms: []*godbi.Atom{atom}}
+	g := MoleculeToGraph(m)
+	if len(g.Nodes[0].AtomActions.CustomItems) != 1 {
+		t.Fatalf("%v", g.Nodes[0].AtomActions)
+	}
+	m1, _, err := GraphToMoleculeE(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ok := m1.Atoms[0].GetAction("count").(*counter)
+	if !ok || c.Column != "x" || len(c.Nextpages) != 1 || c.Nextpages[0].AtomName != "m_b" {
+		t.Errorf("%#v", m1.Atoms[0].Actions)
+	}
+	tryit(m, t)
+
+	custom := g.Nodes[0].AtomActions.CustomItems[0]
+	custom.Body = []byte(`{"column":1}`)
+	if _, _, err = GraphToMoleculeE(g); err == nil || !strings.Contains(err.Error(), "count") {
+		t.Errorf("%v", err)
+	}
+	custom.ActionType = "nothing"
+	if _, _, err = GraphToMoleculeE(g); err == nil || !strings.Contains(err.Error(), "nothing") {
+		t.Errorf("%v", err)
+	}
+	if m2, _ := GraphToMolecule(g); m2 != nil {
+		t.Errorf("%#v", m2)
+	}
+	if _, err = NewRestE(g); err == nil || NewRest(g) != nil {
+		t.Errorf("%v", err)
+	}
+}
+
+func TestGraphActionType(t *testing.T) {
+	atom := new(godbi.Atom)
+	err := json.Unmarshal([]byte(`{"atomName":"m_a","tableName":"m_a","pks":["id"],"actions":[{"actionName":"listX","actionType":"topics","picked":["x"]},{"actionName":"put","actionType":"insert"}]}`), atom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &godbi.Molecule{Atoms: []*godbi.Atom{atom}}
+	g := MoleculeToGraph(m)
+	if n := len(g.Nodes[0].AtomActions.CustomItems); n != 2 {
+		t.Fatalf("%d custom items", n)
+	}
+	m1, _, err := GraphToMoleculeE(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	topics, ok := m1.Atoms[0].GetAction("listX").(*godbi.Topics)
+	if !ok || len(topics.Picked) != 1 || topics.Picked[0] != "x" {
+		t.Errorf("%#v", m1.Atoms[0].Actions)
+	}
+	if insert, ok := m1.Atoms[0].GetAction("put").(*godbi.Insert); !ok || !insert.IsDo {
+		t.Errorf("%#v", m1.Atoms[0].Actions)
+	}
+	tryit(m, t)
+}
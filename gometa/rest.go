@@ -29,9 +29,23 @@ type Rest struct {
 	mole *godbi.Molecule
 }
 
+// NewRest returns the Rest of graph, or nil if the graph can not be
+// translated into molecule. Use NewRestE to get the error.
 func NewRest(graph *Graph) *Rest {
-	mole, _ := GraphToMolecule(graph)
-	return &Rest{graph, mole}
+	rest, err := NewRestE(graph)
+	if err != nil {
+		return nil
+	}
+	return rest
+}
+
+// NewRestE is the same as NewRest, but returns the error of GraphToMoleculeE
+func NewRestE(graph *Graph) (*Rest, error) {
+	mole, _, err := GraphToMoleculeE(graph)
+	if err != nil {
+		return nil, err
+	}
+	return &Rest{graph, mole}, nil
 }
 
 func NewRestByte(bs []byte) (*Rest, error) {
@@ -42,7 +56,7 @@ func NewRestByte(bs []byte) (*Rest, error) {
 		return nil, err
 	}
 
-	return NewRest(graph), nil
+	return NewRestE(graph)
 }
 
 func (r *Rest) nameArgsFromPBExtra(check bool, pb proto.Message, extra ...map[string]any) (string, map[string]any, error) {
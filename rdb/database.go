@@ -2,6 +2,7 @@ package rdb
 
 import (
 	"database/sql"
+	"strings"
 
 	"github.com/genelet/molecule/godbi"
 )
@@ -26,7 +27,7 @@ func (d *database) GetMolecule(db *sql.DB) (*godbi.Molecule, error) {
 		return nil, err
 	}
 
-	refPk := make(map[string]string)
+	refPk := make(map[string][]string)
 
 	var atoms []*godbi.Atom
 	nextpages := make(map[string]map[string][]*godbi.Connection)
@@ -36,10 +37,10 @@ func (d *database) GetMolecule(db *sql.DB) (*godbi.Molecule, error) {
 		if err != nil {
 			return nil, err
 		}
-		if table.Pks != nil && len(table.Pks) == 1 {
-			refPk[table.TableName] = table.Pks[0]
+		if hasPks(table.Pks) {
+			refPk[name] = table.Pks
 		}
-		atom := autoAtom(table)
+		atom := autoAtom(table, refPk[name])
 		fks, err := scheme.getFks(db, name)
 		if err != nil {
 			return nil, err
@@ -62,7 +63,19 @@ func (d *database) GetMolecule(db *sql.DB) (*godbi.Molecule, error) {
 	return &godbi.Molecule{Atoms: newAtoms, DBDriver: d.DBDriver}, nil
 }
 
-func autoAtom(table *godbi.Table) *godbi.Atom {
+func hasPks(pks []string) bool {
+	for _, pk := range pks {
+		if pk == "" {
+			return false
+		}
+	}
+	return len(pks) > 0
+}
+
+// autoAtom builds the atom with default actions. pks is the primary key
+// of the table, which could be composite. The atom is named by the table
+// name, qualified by schema if any.
+func autoAtom(table *godbi.Table, pks []string) *godbi.Atom {
 	edit := new(godbi.Edit)
 	edit.ActionName = "edit"
 	topics := new(godbi.Topics)
@@ -80,22 +93,31 @@ func autoAtom(table *godbi.Table) *godbi.Atom {
 	delett.ActionName = "delete"
 	delett.IsDo = true
 	capas := []godbi.Capability{edit, topics, insert, update, insupd, delett}
+	var relateArgs map[string]string
 	if table.IDAuto != "" {
+		relateArgs = map[string]string{table.IDAuto: table.IDAuto}
+	} else if pks != nil {
+		relateArgs = make(map[string]string)
+		for _, pk := range pks {
+			relateArgs[pk] = pk
+		}
+	}
+	if relateArgs != nil {
 		delecs := new(godbi.Delecs)
 		delecs.ActionName = "delecs"
 		delecs.IsDo = true
 		delecs.Nextpages = []*godbi.Connection{{
-			AtomName:   table.TableName,
+			AtomName:   table.QualifiedName(),
 			ActionName: "delete",
-			RelateArgs: map[string]string{table.IDAuto: table.IDAuto}}}
+			RelateArgs: relateArgs}}
 		capas = append(capas, delecs)
 	}
-	return &godbi.Atom{AtomName: table.TableName, Table: *table, Actions: capas}
+	return &godbi.Atom{AtomName: table.QualifiedName(), Table: *table, Actions: capas}
 }
 
 func autoConnection(table *godbi.Table, fk *godbi.Fk, nextpages, prepares map[string]map[string][]*godbi.Connection) {
-	patom := fk.FkTable
-	tatom := table.TableName
+	patom := fk.GetFkTable()
+	tatom := table.QualifiedName()
 	if nextpages[patom] == nil {
 		nextpages[patom] = make(map[string][]*godbi.Connection)
 	}
@@ -108,25 +130,25 @@ func autoConnection(table *godbi.Table, fk *godbi.Fk, nextpages, prepares map[st
 	for _, actionName := range []string{"topics", "edit"} {
 		def := "topics"
 		nextpage := &godbi.Connection{AtomName: tatom, ActionName: def, Marker: tatom}
-		nextpage.RelateExtra = map[string]string{fk.FkColumn: fk.Column}
+		nextpage.RelateExtra = fk.Relate()
 		nextpages[patom][actionName] = append(nextpages[patom][actionName], nextpage)
 	}
 	for _, actionName := range []string{"insert", "insupd", "update"} {
 		nextpage := &godbi.Connection{AtomName: tatom, ActionName: actionName, Marker: tatom}
-		nextpage.RelateArgs = map[string]string{fk.FkColumn: fk.Column}
+		nextpage.RelateArgs = fk.Relate()
 		nextpages[patom][actionName] = append(nextpages[patom][actionName], nextpage)
 
 		prepare := &godbi.Connection{AtomName: patom, ActionName: actionName, Marker: patom}
-		prepare.RelateArgs = map[string]string{fk.FkColumn: fk.Column}
+		prepare.RelateArgs = fk.Relate()
 		prepares[tatom][actionName] = append(prepares[tatom][actionName], prepare)
 	}
 
-	prepare := &godbi.Connection{AtomName: tatom, ActionName: "delecs", RelateArgs: map[string]string{fk.FkColumn: fk.Column}}
+	prepare := &godbi.Connection{AtomName: tatom, ActionName: "delecs", RelateArgs: fk.Relate()}
 	prepares[patom]["delete"] = append(prepares[patom]["delete"], prepare)
 }
 
 func setConnections(atom *godbi.Atom, nextpages, prepares map[string]map[string][]*godbi.Connection) []godbi.Capability {
-	atomName := atom.Table.TableName
+	atomName := atom.AtomName
 	actions := atom.Actions
 	for tableName, actionMap := range nextpages {
 		if tableName != atomName {
@@ -155,6 +177,68 @@ func setConnections(atom *godbi.Atom, nextpages, prepares map[string]map[string]
 	return actions
 }
 
+// groupFks builds foreign keys from introspected rows, one row per column
+// in the form of [constraint name, parent table, parent column, column].
+// Rows of the same constraint make up a composite foreign key. A key
+// referencing to the same columns of the table itself is skipped.
+// Table names could be qualified by schema, as in splitName.
+func groupFks(tableName string, rows [][4]string) []*godbi.Fk {
+	var names []string
+	groups := make(map[string]*godbi.Fk)
+	for _, row := range rows {
+		fk, ok := groups[row[0]]
+		if !ok {
+			fk = &godbi.Fk{FkTable: row[1]}
+			groups[row[0]] = fk
+			names = append(names, row[0])
+		}
+		fk.FkColumns = append(fk.FkColumns, row[2])
+		fk.Columns = append(fk.Columns, row[3])
+	}
+
+	var fks []*godbi.Fk
+	for _, name := range names {
+		fk := groups[name]
+		if fk.FkTable == tableName && strings.Join(fk.FkColumns, ",") == strings.Join(fk.Columns, ",") {
+			continue
+		}
+		fks = append(fks, newFk(fk.FkTable, fk.FkColumns, fk.Columns))
+	}
+	return fks
+}
+
+// newFk returns the foreign key to the parent table, which could be
+// qualified by schema
+func newFk(fkTable string, fkColumns, columns []string) *godbi.Fk {
+	schema, name := splitName(fkTable)
+	fk := godbi.NewFk(name, fkColumns, columns)
+	fk.FkSchema = schema
+	return fk
+}
+
+// splitName splits a qualified table name into schema and table.
+// The schema is empty if the name is not qualified, or qualified
+// by the default Postgres schema public.
+func splitName(name string) (string, string) {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "", name
+	}
+	schema := name[:i]
+	if schema == defaultSchema {
+		schema = ""
+	}
+	return schema, name[i+1:]
+}
+
+// joinName qualifies table name by schema, unless it is the default
+func joinName(schema, name string) string {
+	if schema == "" || schema == defaultSchema {
+		return name
+	}
+	return schema + "." + name
+}
+
 // toString helper function to safely convert interface{} to string
 func toString(v any) string {
 	if v == nil {
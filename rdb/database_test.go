@@ -0,0 +1,83 @@
+package rdb
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/genelet/molecule/godbi"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestCompositeFks(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, str := range []string{
+		`CREATE TABLE orders (region int, order_no int, PRIMARY KEY (region, order_no))`,
+		`CREATE TABLE item (item_id integer primary key, o_region int, o_no int, sku varchar(8),
+		FOREIGN KEY (o_region, o_no) REFERENCES orders (region, order_no))`,
+	} {
+		if _, err = db.Exec(str); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	object := newSQLite("memory")
+	fks, err := object.getFks(db, "item")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fks) != 1 {
+		t.Fatalf("%#v", fks)
+	}
+	fk := fks[0]
+	if fk.FkTable != "orders" || len(fk.FkColumns) != 2 || fk.FkColumns[1] != "order_no" || fk.Columns[0] != "o_region" {
+		t.Errorf("%#v", fk)
+	}
+
+	molecule, err := object.GetMolecule(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	topics := molecule.GetAtom("orders").GetAction("topics")
+	relate := topics.GetBaseAction().Nextpages[0].RelateExtra
+	if len(relate) != 2 || relate["region"] != "o_region" || relate["order_no"] != "o_no" {
+		t.Errorf("%#v", relate)
+	}
+	prepare := molecule.GetAtom("item").GetAction("insert").GetBaseAction().Prepares[0]
+	if prepare.AtomName != "orders" || prepare.RelateArgs["order_no"] != "o_no" {
+		t.Errorf("%#v", prepare)
+	}
+}
+
+func TestSchemaFks(t *testing.T) {
+	fks := groupFks("sales.orders", [][4]string{
+		{"fk_region", "ref.region", "region_id", "region_id"},
+		{"fk_note", "note", "note_id", "note_id"},
+		{"fk_self", "sales.orders", "order_id", "order_id"},
+	})
+	if len(fks) != 2 {
+		t.Fatalf("%#v", fks)
+	}
+	if fks[0].FkSchema != "ref" || fks[0].FkTable != "region" || fks[0].GetFkTable() != "ref.region" {
+		t.Errorf("%#v", fks[0])
+	}
+	if fks[1].FkSchema != "" || fks[1].FkTable != "note" {
+		t.Errorf("%#v", fks[1])
+	}
+
+	table := &godbi.Table{TableName: "orders", Schema: "sales"}
+	nextpages := make(map[string]map[string][]*godbi.Connection)
+	prepares := make(map[string]map[string][]*godbi.Connection)
+	autoConnection(table, fks[0], nextpages, prepares)
+	if x := nextpages["ref.region"]["topics"]; len(x) != 1 || x[0].AtomName != "sales.orders" {
+		t.Errorf("%#v", x)
+	}
+	if x := prepares["sales.orders"]["insert"]; len(x) != 1 || x[0].AtomName != "ref.region" {
+		t.Errorf("%#v", x)
+	}
+}
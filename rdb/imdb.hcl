@@ -54,6 +54,7 @@ atoms actor {
   }
   
   actions read {
+    actionType = "edit"
     nextpages cast list {
       relateExtra = {
         aid = "aid"
@@ -64,6 +65,7 @@ atoms actor {
   }
   
   actions list {
+    actionType = "topics"
     nextpages cast list {
       relateExtra = {
         aid = "aid"
@@ -233,6 +235,7 @@ atoms copyright {
   }
   
   actions read {
+    actionType = "edit"
     nextpages cast list {
       relateExtra = {
         msid = "msid"
@@ -278,6 +281,7 @@ atoms copyright {
   }
   
   actions list {
+    actionType = "topics"
     nextpages cast list {
       relateExtra = {
         msid = "msid"
@@ -638,6 +642,7 @@ atoms genre {
   }
   
   actions read {
+    actionType = "edit"
     nextpages classification list {
       relateExtra = {
         gid = "gid"
@@ -648,6 +653,7 @@ atoms genre {
   }
   
   actions list {
+    actionType = "topics"
     nextpages classification list {
       relateExtra = {
         gid = "gid"
@@ -882,6 +888,7 @@ atoms director {
   }
   
   actions read {
+    actionType = "edit"
     nextpages directed_by list {
       relateExtra = {
         did = "did"
@@ -892,6 +899,7 @@ atoms director {
   }
   
   actions list {
+    actionType = "topics"
     nextpages directed_by list {
       relateExtra = {
         did = "did"
@@ -1005,6 +1013,7 @@ atoms producer {
   }
   
   actions read {
+    actionType = "edit"
     nextpages made_by list {
       relateExtra = {
         pid = "pid"
@@ -1015,6 +1024,7 @@ atoms producer {
   }
   
   actions list {
+    actionType = "topics"
     nextpages made_by list {
       relateExtra = {
         pid = "pid"
@@ -1200,6 +1210,7 @@ atoms keyword {
   }
   
   actions read {
+    actionType = "edit"
     nextpages tags list {
       relateExtra = {
         kid = "kid"
@@ -1210,6 +1221,7 @@ atoms keyword {
   }
   
   actions list {
+    actionType = "topics"
     nextpages tags list {
       relateExtra = {
         kid = "kid"
@@ -1600,6 +1612,7 @@ atoms writer {
   }
   
   actions read {
+    actionType = "edit"
     nextpages written_by list {
       relateExtra = {
         wid = "wid"
@@ -1610,6 +1623,7 @@ atoms writer {
   }
   
   actions list {
+    actionType = "topics"
     nextpages written_by list {
       relateExtra = {
         wid = "wid"
@@ -42,24 +42,21 @@ func newPostgresIO(databaseName string, src io.Reader) (*postgresIO, error) {
 			}
 			createTable := light.CreateTableTo(xcreateTable)
 			table := fromCreateTable(createTable)
-			tables[table.TableName] = table
+			tables[table.QualifiedName()] = table
 		} else if c, ok := stmt.(*sqlast.AlterTableStmt); ok {
 			xalterTable, err := ast.XAlterTableTo(c)
 			if err != nil {
 				return nil, err
 			}
 			alterTable := light.AlterTableTo(xalterTable)
-			tname := strings.Join(alterTable.TableName.Idents, ".")
+			tname := joinName(splitName(strings.Join(alterTable.TableName.Idents, ".")))
 			if x := alterTable.Action.GetAddConstraintItem(); x != nil {
 				if y := x.Spec.GetReferenceItem(); y != nil {
 					if fks[tname] == nil {
 						fks[tname] = make([]*godbi.Fk, 0)
 					}
 					expr := y.KeyExpr
-					fks[tname] = append(fks[tname], &godbi.Fk{
-						FkTable:  expr.TableName,
-						FkColumn: expr.Columns[0],
-						Column:   y.Columns[0]})
+					fks[tname] = append(fks[tname], newFk(expr.TableName, expr.Columns, y.Columns))
 				}
 			}
 		}
@@ -85,7 +82,8 @@ func dataTypeToTypeName(dt *xlight.Type) string {
 }
 
 func fromCreateTable(createTable *xlight.CreateTableStmt) *godbi.Table {
-	var pk, idauto string
+	var pks []string
+	var idauto string
 	var cols []*godbi.Col
 	var uniques []string
 
@@ -114,19 +112,26 @@ func fromCreateTable(createTable *xlight.CreateTableStmt) *godbi.Table {
 					col.Notnull = true
 				} else if z := spec.GetUniqueItem(); z != nil {
 					if z.IsPrimaryKey {
-						pk = x.Name
+						pks = append(pks, x.Name)
 					}
 					uniques = append(uniques, x.Name)
 				}
 			}
 			cols = append(cols, col)
+		} else if x := item.GetTableConstraintElement(); x != nil {
+			// a composite primary key is defined as table constraint
+			if y := x.Spec.GetUniqueItem(); y != nil && y.IsPrimary {
+				pks = append(pks, y.Columns...)
+			}
 		}
 	}
 
+	schema, name := splitName(strings.Join(createTable.Name.Idents, "."))
 	return &godbi.Table{
-		TableName: strings.Join(createTable.Name.Idents, "."),
+		TableName: name,
+		Schema:    schema,
 		Columns:   cols,
-		Pks:       []string{pk},
+		Pks:       pks,
 		IDAuto:    idauto}
 }
 
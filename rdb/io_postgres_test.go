@@ -2,6 +2,7 @@ package rdb
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -36,3 +37,28 @@ func TestPostgresIO(t *testing.T) {
 		}
 	*/
 }
+
+func TestPostgresIOSchema(t *testing.T) {
+	src := `CREATE TABLE sales.orders (order_id SERIAL PRIMARY KEY, region_id INT NOT NULL);
+CREATE TABLE public.note (note_id SERIAL PRIMARY KEY, body VARCHAR(32));
+`
+	object, err := newPostgresIO("tutorial", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	molecule, err := object.GetMolecule(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orders := molecule.GetAtom("sales.orders")
+	if orders == nil || orders.Schema != "sales" || orders.TableName != "orders" {
+		t.Fatalf("%#v", orders)
+	}
+	if delecs := orders.GetAction("delecs"); delecs.GetBaseAction().Nextpages[0].AtomName != "sales.orders" {
+		t.Errorf("%#v", delecs)
+	}
+	if note := molecule.GetAtom("note"); note == nil || note.Schema != "" {
+		t.Errorf("%#v", note)
+	}
+}
@@ -14,15 +14,17 @@ import (
 // driver: the DBType defined in molecule. Currently only the three databases
 // are supported, godbi.Postgres, godbi.MySQL and godbi.SQLite
 // dbName: the name of database
-// 
-func NewMolecule(db *sql.DB, driver godbi.DBType, dbName string) (*godbi.Molecule, error) {
+// schemas: the Postgres schemas to introspect, default to public. Tables
+// not in public are qualified by schema in atom names, e.g. sales.orders.
+// It is ignored by other databases.
+func NewMolecule(db *sql.DB, driver godbi.DBType, dbName string, schemas ...string) (*godbi.Molecule, error) {
 	switch driver {
 	case godbi.MySQL:
 		return newMySQL(dbName).GetMolecule(db)
 	case godbi.SQLite:
 		return newSQLite(dbName).GetMolecule(db)
 	case godbi.Postgres:
-		return newPostgres(dbName).GetMolecule(db)
+		return newPostgres(dbName, schemas...).GetMolecule(db)
 	default:
 	}
 	return nil, fmt.Errorf("db type %v not found", driver)
@@ -46,7 +46,7 @@ func (m *mySQL) getTable(db *sql.DB, tableName string) (*godbi.Table, error) {
 	dbi := &godbi.DBI{DB: db}
 	lists := make([]any, 0)
 	err := dbi.SelectSQL(&lists,
-		`DESC `+tableName,
+		"DESC `"+strings.ReplaceAll(tableName, "`", "``")+"`",
 		[]any{"Field", "Type", "Null", "Key", "Default", "Extra"})
 	if err != nil {
 		return nil, err
@@ -93,7 +93,8 @@ func (m *mySQL) getFks(db *sql.DB, tableName string) ([]*godbi.Fk, error) {
 	dbi := &godbi.DBI{DB: db}
 	lists := make([]any, 0)
 	err := dbi.Select(&lists,
-		`SELECT A.REFERENCED_TABLE_SCHEMA AS FKTABLE_SCHEM,
+		`SELECT A.CONSTRAINT_NAME AS FK_NAME,
+	A.REFERENCED_TABLE_SCHEMA AS FKTABLE_SCHEM,
 	A.REFERENCED_TABLE_NAME AS FKTABLE_NAME,
 	A.REFERENCED_COLUMN_NAME AS FKCOLUMN_NAME,
 	A.TABLE_SCHEMA AS PKTABLE_SCHEM,
@@ -107,31 +108,31 @@ AND (A.CONSTRAINT_NAME = B.CONSTRAINT_NAME)
 AND (B.CONSTRAINT_TYPE IS NOT NULL)
 AND A.REFERENCED_TABLE_NAME IS NOT NULL
 AND A.TABLE_SCHEMA=?
-AND A.TABLE_NAME=?`, m.DatabaseName, tableName)
+AND A.TABLE_NAME=?
+ORDER BY A.CONSTRAINT_NAME, A.ORDINAL_POSITION`, m.DatabaseName, tableName)
 	if err != nil {
 		return nil, err
 	}
 
-	var fks []*godbi.Fk
+	var rows [][4]string
 	for _, iitem := range lists {
 		item := iitem.(map[string]any)
-		fkTable := toString(item["FKTABLE_NAME"])
-		fkColumn := toString(item["FKCOLUMN_NAME"])
-		column := toString(item["PKCOLUMN_NAME"])
-		if fkTable == tableName && fkColumn == column {
-			continue
-		}
-		fks = append(fks, &godbi.Fk{FkTable: fkTable, FkColumn: fkColumn, Column: column})
+		rows = append(rows, [4]string{
+			toString(item["FK_NAME"]),
+			toString(item["FKTABLE_NAME"]),
+			toString(item["FKCOLUMN_NAME"]),
+			toString(item["PKCOLUMN_NAME"])})
 	}
 
-	return fks, nil
+	return groupFks(tableName, rows), nil
 }
 
 func (m *mySQL) getFwks(db *sql.DB, tableName string) ([]*godbi.Fk, error) {
 	dbi := &godbi.DBI{DB: db}
 	lists := make([]any, 0)
 	err := dbi.Select(&lists,
-		`SELECT A.REFERENCED_TABLE_SCHEMA AS PKTABLE_SCHEM,
+		`SELECT A.CONSTRAINT_NAME AS FK_NAME,
+	A.REFERENCED_TABLE_SCHEMA AS PKTABLE_SCHEM,
 	A.REFERENCED_TABLE_NAME AS PKTABLE_NAME,
 	A.REFERENCED_COLUMN_NAME AS PKCOLUMN_NAME,
 	A.TABLE_SCHEMA AS FKTABLE_SCHEM,
@@ -144,24 +145,23 @@ AND (A.TABLE_NAME = B.TABLE_NAME)
 AND (A.CONSTRAINT_NAME = B.CONSTRAINT_NAME)
 AND (B.CONSTRAINT_TYPE IS NOT NULL)
 AND A.REFERENCED_TABLE_SCHEMA=?
-AND A.REFERENCED_TABLE_NAME=?`, m.DatabaseName, tableName)
+AND A.REFERENCED_TABLE_NAME=?
+ORDER BY A.CONSTRAINT_NAME, A.ORDINAL_POSITION`, m.DatabaseName, tableName)
 	if err != nil {
 		return nil, err
 	}
 
-	var fks []*godbi.Fk
+	var rows [][4]string
 	for _, iitem := range lists {
 		item := iitem.(map[string]any)
-		fkTable := toString(item["FKTABLE_NAME"])
-		fkColumn := toString(item["FKCOLUMN_NAME"])
-		column := toString(item["PKCOLUMN_NAME"])
-		if fkTable == tableName && fkColumn == column {
-			continue
-		}
-		fks = append(fks, &godbi.Fk{FkTable: fkTable, FkColumn: fkColumn, Column: column})
+		rows = append(rows, [4]string{
+			toString(item["FK_NAME"]),
+			toString(item["FKTABLE_NAME"]),
+			toString(item["FKCOLUMN_NAME"]),
+			toString(item["PKCOLUMN_NAME"])})
 	}
 
-	return fks, nil
+	return groupFks(tableName, rows), nil
 }
 
 func (m *mySQL) tableNames(db *sql.DB) ([]string, error) {
@@ -7,13 +7,21 @@ import (
 	"github.com/genelet/molecule/godbi"
 )
 
+// defaultSchema is the default Postgres schema
+const defaultSchema = "public"
+
 type postgres struct {
 	database
+	// Schemas are the schemas to introspect, default to public
+	Schemas []string
 }
 
-func newPostgres(databaseName string) *postgres {
+func newPostgres(databaseName string, schemas ...string) *postgres {
+	if schemas == nil {
+		schemas = []string{defaultSchema}
+	}
 	team := database{DBDriver: godbi.Postgres, DatabaseName: databaseName}
-	postgres := &postgres{team}
+	postgres := &postgres{team, schemas}
 	postgres.database.schema = postgres
 	return postgres
 }
@@ -51,12 +59,19 @@ func postgresToNative(u string) string {
 	return "string"
 }
 
-func (p *postgres) getTable(db *sql.DB, tableName string) (*godbi.Table, error) {
+// getTable introspects the table, whose name is qualified by schema
+// if it is not in the default schema
+func (p *postgres) getTable(db *sql.DB, qualified string) (*godbi.Table, error) {
+	schema, tableName := splitName(qualified)
+	nspname := schema
+	if nspname == "" {
+		nspname = defaultSchema
+	}
 	dbi := &godbi.DBI{DB: db}
 	lists := make([]any, 0)
 	err := dbi.Select(&lists,
 		`SELECT
-	pg_catalog.quote_ident(a.attname) AS "COLUMN_NAME"
+	a.attname AS "COLUMN_NAME"
 	, pg_catalog.format_type(a.atttypid, NULL) AS "TYPE_NAME"
 	, pg_catalog.pg_get_expr(af.adbin, af.adrelid) AS "COLUMN_DEF"
 	, CASE a.attnotnull WHEN 't' THEN 'NO' ELSE 'YES' END AS "IS_NULLABLE"
@@ -66,9 +81,9 @@ JOIN pg_catalog.pg_class c ON (a.attrelid = c.oid)
 LEFT JOIN pg_catalog.pg_attrdef af ON (a.attnum = af.adnum AND a.attrelid = af.adrelid)
 JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
 WHERE a.attnum >= 0 AND c.relkind IN ('r','p','v','m','f')
-AND pg_catalog.quote_ident(n.nspname) = 'public'
-AND pg_catalog.quote_ident(pg_catalog.current_database()) = ?
-AND pg_catalog.quote_ident(c.relname) = ?`, p.DatabaseName, tableName)
+AND n.nspname = ?
+AND pg_catalog.current_database() = ?
+AND c.relname = ?`, nspname, p.DatabaseName, tableName)
 	if err != nil {
 		return nil, err
 	}
@@ -109,10 +124,10 @@ JOIN information_schema.key_column_usage kcu
 ON	kcu.constraint_schema = tco.constraint_schema AND
 	kcu.constraint_name = tco.constraint_name
 WHERE tco.constraint_type = 'PRIMARY KEY'
-AND kcu.table_schema = 'public'
+AND kcu.table_schema = ?
 AND tco.constraint_catalog = ?
 AND kcu.table_name = ?
-ORDER BY kcu.ordinal_position`, p.DatabaseName, tableName)
+ORDER BY kcu.ordinal_position`, nspname, p.DatabaseName, tableName)
 	if err != nil {
 		return nil, err
 	}
@@ -135,60 +150,74 @@ ORDER BY kcu.ordinal_position`, p.DatabaseName, tableName)
 
 	return &godbi.Table{
 		TableName: tableName,
+		Schema:    schema,
 		Columns:   cols,
 		Pks:       pks,
 		IDAuto:    idauto}, nil
 }
 
-func (p *postgres) getFks(db *sql.DB, tableName string) ([]*godbi.Fk, error) {
+func (p *postgres) getFks(db *sql.DB, qualified string) ([]*godbi.Fk, error) {
+	schema, tableName := splitName(qualified)
+	if schema == "" {
+		schema = defaultSchema
+	}
 	dbi := &godbi.DBI{DB: db}
 	lists := make([]any, 0)
-	// from child table: constraint_name | table_name  | column_name | foreign_table_name | foreign_column_name
-	// poll_choice_poll_id..poll_poll_id | poll_choice | poll_id     | poll_question      | poll_id
+	// from child table, one row per column of the foreign key:
+	// constraint_name                 | column_name | foreign_table_name | foreign_column_name
+	// poll_choice_poll_id..poll_poll_id | poll_id     | poll_question      | poll_id
 	err := dbi.Select(&lists,
-		`SELECT tc.constraint_name, tc.table_name, 
-    kcu.column_name, 
-    ccu.table_name AS foreign_table_name,
-    ccu.column_name AS foreign_column_name 
-FROM information_schema.table_constraints AS tc 
-JOIN information_schema.key_column_usage AS kcu
-	ON tc.constraint_name = kcu.constraint_name
-	AND tc.table_schema = kcu.table_schema
-JOIN information_schema.constraint_column_usage AS ccu
-	ON ccu.constraint_name = tc.constraint_name
-	AND ccu.table_schema = tc.table_schema
-WHERE tc.constraint_type = 'FOREIGN KEY'
-AND tc.constraint_catalog = ?
-AND tc.table_schema='public'
-AND ccu.table_schema='public'
-AND ccu.table_name=?`, p.DatabaseName, tableName)
+		`SELECT c.conname AS constraint_name,
+	col.attname AS column_name,
+	f_sch.nspname AS foreign_table_schema,
+	f_tbl.relname AS foreign_table_name,
+	f_col.attname AS foreign_column_name
+FROM pg_catalog.pg_constraint c
+JOIN LATERAL UNNEST(c.conkey) WITH ORDINALITY AS u(attnum, attposition) ON TRUE
+JOIN LATERAL UNNEST(c.confkey) WITH ORDINALITY AS f_u(attnum, attposition) ON f_u.attposition = u.attposition
+JOIN pg_catalog.pg_class tbl ON tbl.oid = c.conrelid
+JOIN pg_catalog.pg_namespace sch ON sch.oid = tbl.relnamespace
+JOIN pg_catalog.pg_attribute col ON (col.attrelid = tbl.oid AND col.attnum = u.attnum)
+JOIN pg_catalog.pg_class f_tbl ON f_tbl.oid = c.confrelid
+JOIN pg_catalog.pg_namespace f_sch ON f_sch.oid = f_tbl.relnamespace
+JOIN pg_catalog.pg_attribute f_col ON (f_col.attrelid = f_tbl.oid AND f_col.attnum = f_u.attnum)
+WHERE c.contype = 'f'
+AND pg_catalog.current_database() = ?
+AND sch.nspname = ?
+AND tbl.relname = ?
+ORDER BY c.conname, u.attposition`, p.DatabaseName, schema, tableName)
 	if err != nil {
 		return nil, err
 	}
 
-	var fks []*godbi.Fk
+	var rows [][4]string
 	for _, iitem := range lists {
 		item := iitem.(map[string]any)
-		fkTable := item["foreign_table_name"].(string)
-		fkColumn := item["foreign_column_name"].(string)
-		column := item["column_name"].(string)
-		if fkTable == tableName && fkColumn == column {
-			continue
-		}
-		fks = append(fks, &godbi.Fk{FkTable: fkTable, FkColumn: fkColumn, Column: column})
+		rows = append(rows, [4]string{
+			toString(item["constraint_name"]),
+			joinName(toString(item["foreign_table_schema"]), toString(item["foreign_table_name"])),
+			toString(item["foreign_column_name"]),
+			toString(item["column_name"])})
 	}
 
-	return fks, nil
+	return groupFks(qualified, rows), nil
 }
 
+// tableNames returns names of tables in the schemas, qualified by
+// schema if it is not the default
 func (p *postgres) tableNames(db *sql.DB) ([]string, error) {
 	dbi := &godbi.DBI{DB: db}
 	lists := make([]any, 0)
+	args := []any{p.DatabaseName}
+	for _, schema := range p.Schemas {
+		args = append(args, schema)
+	}
 	err := dbi.Select(&lists,
-		`SELECT table_name FROM information_schema.tables
-WHERE table_schema='public'
-AND table_type='BASE TABLE'
-AND table_catalog=?`, p.DatabaseName)
+		`SELECT table_schema, table_name FROM information_schema.tables
+WHERE table_type='BASE TABLE'
+AND table_catalog=?
+AND table_schema IN (`+strings.Join(strings.Split(strings.Repeat("?", len(p.Schemas)), ""), ",")+`)
+ORDER BY table_schema, table_name`, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -196,33 +225,7 @@ AND table_catalog=?`, p.DatabaseName)
 	names := make([]string, 0)
 	for _, iitem := range lists {
 		item := iitem.(map[string]any)
-		names = append(names, item["table_name"].(string))
+		names = append(names, joinName(toString(item["table_schema"]), toString(item["table_name"])))
 	}
 	return names, nil
 }
-
-/*
-psql
-\l
-\c tustin
-SELECT c.conname                                 AS constraint_name,
-   c.contype                                     AS constraint_type,
-   sch.nspname                                   AS "self_schema",
-   tbl.relname                                   AS "self_table",
-   ARRAY_AGG(col.attname ORDER BY u.attposition) AS "self_columns",
-   f_sch.nspname                                 AS "foreign_schema",
-   f_tbl.relname                                 AS "foreign_table",
-   ARRAY_AGG(f_col.attname ORDER BY f_u.attposition) AS "foreign_columns",
-   pg_get_constraintdef(c.oid)                   AS definition
-FROM pg_constraint c
-       LEFT JOIN LATERAL UNNEST(c.conkey) WITH ORDINALITY AS u(attnum, attposition) ON TRUE
-       LEFT JOIN LATERAL UNNEST(c.confkey) WITH ORDINALITY AS f_u(attnum, attposition) ON f_u.attposition = u.attposition
-       JOIN pg_class tbl ON tbl.oid = c.conrelid
-       JOIN pg_namespace sch ON sch.oid = tbl.relnamespace
-       LEFT JOIN pg_attribute col ON (col.attrelid = tbl.oid AND col.attnum = u.attnum)
-       LEFT JOIN pg_class f_tbl ON f_tbl.oid = c.confrelid
-       LEFT JOIN pg_namespace f_sch ON f_sch.oid = f_tbl.relnamespace
-       LEFT JOIN pg_attribute f_col ON (f_col.attrelid = f_tbl.oid AND f_col.attnum = f_u.attnum)
-GROUP BY constraint_name, constraint_type, "self_schema", "self_table", definition, "foreign_schema", "foreign_table"
-ORDER BY "self_schema", "self_table";
-*/
@@ -66,7 +66,7 @@ func (s *sQLite) getTable(db *sql.DB, tableName string) (*godbi.Table, error) {
 	dbi := &godbi.DBI{DB: db}
 	lists := make([]any, 0)
 	err := dbi.SelectSQL(&lists,
-		`PRAGMA table_info(`+tableName+`)`,
+		`PRAGMA table_info("`+strings.ReplaceAll(tableName, `"`, `""`)+`")`,
 		[]any{[2]string{"cid", "int"}, [2]string{"name", "string"}, "type", [2]string{"notnull", "int"}, [2]string{"default", "string"}, [2]string{"pk", "int"}})
 	if err != nil {
 		return nil, err
@@ -126,28 +126,27 @@ func (s *sQLite) getFks(db *sql.DB, tableName string) ([]*godbi.Fk, error) {
 		   on_update and on_delete - the actions taken when the referenced foreign key is updated or deleted.
 		   match - A SQL92 feature for foreign key actions related to null values that sqlite doesn't implement but does accept syntax-wise in case it ever does add support.
 		*/
-		`SELECT m.name, p."table", p."from", p."to"
+		`SELECT m.name, p."id", p."table", p."from", p."to"
 FROM sqlite_master m
 JOIN pragma_foreign_key_list(m.name) p ON m.name != p."table"
 WHERE m.type = 'table'
-AND m.name=?`, []any{"name", "table", "from", "to"}, tableName)
+AND m.name=?
+ORDER BY p."id", p."seq"`, []any{"name", "id", "table", "from", "to"}, tableName)
 	if err != nil {
 		return nil, err
 	}
 
-	var fks []*godbi.Fk
+	var rows [][4]string
 	for _, iitem := range lists {
 		item := iitem.(map[string]any)
-		fkTable := toString(item["table"])
-		fkColumn := toString(item["to"])
-		column := toString(item["from"])
-		if fkTable == tableName && fkColumn == column {
-			continue
-		}
-		fks = append(fks, &godbi.Fk{FkTable: fkTable, FkColumn: fkColumn, Column: column})
+		rows = append(rows, [4]string{
+			toString(item["id"]),
+			toString(item["table"]),
+			toString(item["to"]),
+			toString(item["from"])})
 	}
 
-	return fks, nil
+	return groupFks(tableName, rows), nil
 }
 
 func (s *sQLite) tableNames(db *sql.DB) ([]string, error) {
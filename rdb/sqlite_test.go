@@ -2,9 +2,11 @@ package rdb
 
 import (
 	"database/sql"
+	"encoding/json"
 	"os"
 	"testing"
 
+	"github.com/genelet/molecule/godbi"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -36,4 +38,41 @@ func TestSQLite(t *testing.T) {
 	if string(data) != String(molecule) {
 		t.Errorf("not equal: %s", String(molecule))
 	}
+
+	// loading adds the default actions, and JSON reorders them, so
+	// compare action by action with the JSON reloaded
+	fromJSON := new(godbi.Molecule)
+	if err = json.Unmarshal(data, fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	bs, err := molecule.MarshalHCL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromHCL := new(godbi.Molecule)
+	if err = fromHCL.UnmarshalHCL(bs); err != nil {
+		t.Fatal(err)
+	}
+	if len(fromHCL.Atoms) != len(fromJSON.Atoms) {
+		t.Fatalf("HCL not equal: %s", bs)
+	}
+	for _, atom := range fromJSON.Atoms {
+		other := fromHCL.GetAtom(atom.AtomName)
+		if other == nil || len(other.Actions) != len(atom.Actions) {
+			t.Fatalf("HCL atom %s not equal: %s", atom.AtomName, bs)
+		}
+		expected, _ := json.Marshal(atom.Table)
+		got, _ := json.Marshal(other.Table)
+		if string(got) != string(expected) {
+			t.Errorf("%s: %s", atom.AtomName, got)
+		}
+		for _, action := range atom.Actions {
+			name := action.GetBaseAction().ActionName
+			expected, _ := json.Marshal(action)
+			got, _ := json.Marshal(other.GetAction(name))
+			if string(got) != string(expected) {
+				t.Errorf("%s %s: %s", atom.AtomName, name, got)
+			}
+		}
+	}
 }